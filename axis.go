@@ -1,19 +1,51 @@
 package gochart
 
 import (
-	"github.com/fogleman/gg"
+	"math"
+
 	"github.com/warmans/gochart/pkg/style"
 )
 
 type XAxis interface {
 	Scale() XScale
-	Render(canvas *gg.Context, b BoundingBox) error
-	Height(canvas *gg.Context) float64
+	Render(canvas Renderer, b BoundingBox) error
+	Height(canvas Renderer) float64
 }
 
 type YAxis interface {
 	Scale() YScale
-	Render(canvas *gg.Context, b BoundingBox) error
+	Render(canvas Renderer, b BoundingBox) error
+}
+
+func newAxisTitle() axisTitle {
+	return axisTitle{styles: newThemeStyles(DefaultTheme.FontStyles)}
+}
+
+type axisTitle struct {
+	text   string
+	styles Styles
+}
+
+// size is the space needed to draw the title across the axis, or zero if there is no title.
+func (t axisTitle) size(canvas Renderer) float64 {
+	if t.text == "" {
+		return 0
+	}
+	return titleHeight(canvas, t.styles)
+}
+
+// yAxisWidth is the space needed to draw the Y axis beside a plot of the given height.
+func yAxisWidth(canvas Renderer, axis YAxis, height float64) float64 {
+	switch a := axis.(type) {
+	case *CategoryYAxis:
+		return a.width(canvas)
+	case *YStdAxis:
+		maxLabelW, _ := widestLabelSize(canvas, fitYLabels(a.scale, height))
+		return maxLabelW + defaultMargin + a.title.size(canvas)
+	default:
+		maxLabelW, _ := widestLabelSize(canvas, fitYLabels(axis.Scale(), height))
+		return maxLabelW + defaultMargin
+	}
 }
 
 func MirrorYStdAxis() YStdAxisOpt {
@@ -34,6 +66,14 @@ func YLineStyles(opt ...style.Opt) YStdAxisOpt {
 	}
 }
 
+// YAxisTitle adds a title drawn vertically alongside the labels.
+func YAxisTitle(title string, opt ...style.Opt) YStdAxisOpt {
+	return func(ax *YStdAxis) {
+		ax.title.text = title
+		ax.title.styles.SetStyle(opt...)
+	}
+}
+
 type YStdAxisOpt func(ax *YStdAxis)
 
 type YStdAxisConfig struct {
@@ -42,10 +82,11 @@ type YStdAxisConfig struct {
 
 func NewStdYAxis(scale YScale, opts ...YStdAxisOpt) *YStdAxis {
 	y := &YStdAxis{
-		lineStyles: NewStyles(style.DefaultAxisOpts...),
-		fontStyles: NewStyles(style.DefaultAxisOpts...),
+		lineStyles: newThemeStyles(DefaultTheme.AxisStyles),
+		fontStyles: newThemeStyles(DefaultTheme.FontStyles),
 		scale:      scale,
 		cfg:        &YStdAxisConfig{},
+		title:      newAxisTitle(),
 	}
 	for _, opt := range opts {
 		opt(y)
@@ -58,13 +99,14 @@ type YStdAxis struct {
 	fontStyles Styles
 	scale      YScale
 	cfg        *YStdAxisConfig
+	title      axisTitle
 }
 
 func (a *YStdAxis) Scale() YScale {
 	return a.scale
 }
 
-func (a *YStdAxis) Render(canvas *gg.Context, b BoundingBox) error {
+func (a *YStdAxis) Render(canvas Renderer, b BoundingBox) error {
 	canvas.Push()
 	defer canvas.Pop()
 
@@ -78,17 +120,33 @@ func (a *YStdAxis) Render(canvas *gg.Context, b BoundingBox) error {
 	// vertical line
 	canvas.DrawLine(verticalLinePos, b.RelY(0), verticalLinePos, b.RelY(b.H))
 
-	_, max := a.scale.MinMax()
+	// the title is drawn on the outside of the labels.
+	titleSize := a.title.size(canvas)
+	if titleSize > 0 {
+		titlePos := b.RelX(titleSize / 2)
+		if a.cfg.Mirrored {
+			titlePos = b.RelX(b.W - titleSize/2)
+		}
+		canvas.Push()
+		a.title.styles.styleOpts.Apply(canvas)
+		canvas.RotateAbout(-math.Pi/2, titlePos, b.RelY(b.H/2))
+		canvas.DrawStringAnchored(a.title.text, titlePos, b.RelY(b.H/2), 0.5, 0.35)
+		canvas.Pop()
+	}
 
-	for i, label := range a.scale.Labels() {
+	for _, label := range fitYLabels(a.scale, b.H) {
 
-		spacing := max / float64(a.scale.NumTicks())
-		linePos := a.scale.Position(spacing*float64(i), b)
+		linePos := a.scale.Position(label.At, b)
+
+		tickSize := defaultTickSize
+		if label.Minor {
+			tickSize = defaultTickSize / 2
+		}
 
 		// end position of tick line
-		tickLinePos := verticalLinePos - defaultTickSize
+		tickLinePos := verticalLinePos - tickSize
 		if a.cfg.Mirrored {
-			tickLinePos = verticalLinePos + defaultTickSize
+			tickLinePos = verticalLinePos + tickSize
 		}
 		canvas.DrawLine(
 			tickLinePos,
@@ -96,29 +154,33 @@ func (a *YStdAxis) Render(canvas *gg.Context, b BoundingBox) error {
 			verticalLinePos,
 			linePos,
 		)
+		if label.Minor {
+			continue
+		}
 
 		canvas.Push()
 		a.fontStyles.styleOpts.Apply(canvas)
 
-		textAlign := gg.AlignRight
+		align := alignRight
 		if a.cfg.Mirrored {
-			textAlign = gg.AlignLeft
+			align = alignLeft
 		}
 
-		textStartPos := b.RelX(0)
+		textStartPos := b.RelX(titleSize)
 		if a.cfg.Mirrored {
 			textStartPos = b.RelX(0) + defaultTickSize + defaultMargin
 		}
 
-		canvas.DrawStringWrapped(
-			truncateStringToMaxSize(canvas, label.Value, b.W),
+		drawStringWrapped(
+			canvas,
+			truncateStringToMaxSize(canvas, label.Value, b.W-titleSize),
 			textStartPos,
 			linePos,
 			0,
 			0.5,
-			b.W-(defaultTickSize+defaultMargin),
+			b.W-(defaultTickSize+defaultMargin+titleSize),
 			0,
-			textAlign,
+			align,
 		)
 		canvas.Pop()
 	}
@@ -129,11 +191,12 @@ func (a *YStdAxis) Render(canvas *gg.Context, b BoundingBox) error {
 
 func NewStdXAxis(s Series, xScale XScale, opts ...XAxisOpt) *XStdAxis {
 	x := &XStdAxis{
-		lineStyles: NewStyles(style.DefaultAxisOpts...),
-		fontStyles: NewStyles(style.DefaultAxisOpts...),
+		lineStyles: newThemeStyles(DefaultTheme.AxisStyles),
+		fontStyles: newThemeStyles(DefaultTheme.FontStyles),
 		s:          s,
 		xScale:     xScale,
 		labelAlign: 0.5,
+		title:      newAxisTitle(),
 	}
 	for _, o := range opts {
 		o(x)
@@ -155,6 +218,14 @@ func XLineStyles(opt ...style.Opt) XAxisOpt {
 	}
 }
 
+// XAxisTitle adds a title drawn below the labels.
+func XAxisTitle(title string, opt ...style.Opt) XAxisOpt {
+	return func(ax *XStdAxis) {
+		ax.title.text = title
+		ax.title.styles.SetStyle(opt...)
+	}
+}
+
 // XLabelAlign aligns the label from left to right.
 // 0 = left
 // 0.5 = center
@@ -171,17 +242,18 @@ type XStdAxis struct {
 	s          Series
 	xScale     XScale
 	labelAlign float64
+	title      axisTitle
 }
 
 func (a *XStdAxis) Scale() XScale {
 	return a.xScale
 }
 
-func (a *XStdAxis) Height(canvas *gg.Context) float64 {
-	return canvas.FontHeight() + defaultMargin
+func (a *XStdAxis) Height(canvas Renderer) float64 {
+	return canvas.FontHeight() + defaultMargin + a.title.size(canvas)
 }
 
-func (a *XStdAxis) Render(canvas *gg.Context, b BoundingBox) error {
+func (a *XStdAxis) Render(canvas Renderer, b BoundingBox) error {
 
 	canvas.Push()
 	defer canvas.Pop()
@@ -191,7 +263,7 @@ func (a *XStdAxis) Render(canvas *gg.Context, b BoundingBox) error {
 	// horizontal line
 	canvas.DrawLine(b.RelX(0), b.RelY(0), b.RelX(b.W), b.RelY(0))
 
-	labels := reduceNumLabelsToFitSpace(canvas, a.xScale.Labels(), b.W)
+	labels := fitLabels(canvas, a.xScale, b.W)
 	totalLabelsWidth := totalLabelsWidth(canvas, labels, defaultMargin*2)
 	spacing := totalLabelsWidth / float64(len(labels))
 
@@ -199,7 +271,7 @@ func (a *XStdAxis) Render(canvas *gg.Context, b BoundingBox) error {
 
 	for _, label := range labels {
 
-		linePos := a.xScale.Position(label.Tick, b) + tickWidth/2
+		linePos := tickPosition(a.xScale, label, tickWidth, b)
 
 		canvas.DrawLine(
 			linePos,
@@ -211,7 +283,8 @@ func (a *XStdAxis) Render(canvas *gg.Context, b BoundingBox) error {
 		canvas.Push()
 		a.fontStyles.styleOpts.Apply(canvas)
 
-		canvas.DrawStringWrapped(
+		drawStringWrapped(
+			canvas,
 			label.Value,
 			linePos,
 			b.RelY(0)+defaultTickSize,
@@ -219,13 +292,20 @@ func (a *XStdAxis) Render(canvas *gg.Context, b BoundingBox) error {
 			0,
 			spacing,
 			1,
-			gg.AlignCenter,
+			alignCenter,
 		)
 		canvas.Pop()
 	}
 
 	canvas.Stroke()
 
+	if titleSize := a.title.size(canvas); titleSize > 0 {
+		canvas.Push()
+		a.title.styles.styleOpts.Apply(canvas)
+		canvas.DrawStringAnchored(a.title.text, b.RelX(b.W/2), b.RelY(b.H-titleSize/2), 0.5, 0.35)
+		canvas.Pop()
+	}
+
 	return nil
 }
 
@@ -239,8 +319,8 @@ func XCompactFontStyles(opt ...style.Opt) XAxisCompactOpt {
 
 func NewCompactXAxis(labels []string, xScale XScale, opts ...XAxisCompactOpt) *XAxisCompact {
 	x := &XAxisCompact{
-		lineStyles: NewStyles(style.DefaultAxisOpts...),
-		fontStyles: NewStyles(style.DefaultAxisOpts...),
+		lineStyles: newThemeStyles(DefaultTheme.AxisStyles),
+		fontStyles: newThemeStyles(DefaultTheme.FontStyles),
 		xScale:     xScale,
 		labelAlign: 0,
 		labels:     labels,
@@ -263,7 +343,7 @@ func (a *XAxisCompact) Scale() XScale {
 	return a.xScale
 }
 
-func (a *XAxisCompact) Height(canvas *gg.Context) float64 {
+func (a *XAxisCompact) Height(canvas Renderer) float64 {
 	canvas.Push()
 	defer canvas.Pop()
 
@@ -282,7 +362,7 @@ func (a *XAxisCompact) Height(canvas *gg.Context) float64 {
 	return longest + defaultMargin
 }
 
-func (a *XAxisCompact) Render(canvas *gg.Context, b BoundingBox) error {
+func (a *XAxisCompact) Render(canvas Renderer, b BoundingBox) error {
 	canvas.Push()
 	defer canvas.Pop()
 
@@ -297,7 +377,7 @@ func (a *XAxisCompact) Render(canvas *gg.Context, b BoundingBox) error {
 
 	for _, label := range labels {
 
-		linePos := a.xScale.Position(label.Tick, b) + tickWidth/2
+		linePos := tickPosition(a.xScale, label, tickWidth, b)
 
 		canvas.DrawLine(
 			linePos,
@@ -329,3 +409,228 @@ func (a *XAxisCompact) Render(canvas *gg.Context, b BoundingBox) error {
 
 	return nil
 }
+
+type CategoryYAxisOpt func(ax *CategoryYAxis)
+
+func CategoryFontStyles(opt ...style.Opt) CategoryYAxisOpt {
+	return func(ax *CategoryYAxis) {
+		ax.fontStyles.SetStyle(opt...)
+	}
+}
+
+func CategoryLineStyles(opt ...style.Opt) CategoryYAxisOpt {
+	return func(ax *CategoryYAxis) {
+		ax.lineStyles.SetStyle(opt...)
+	}
+}
+
+// CategoryMaxLabelWidth truncates labels wider than the given width.
+func CategoryMaxLabelWidth(width float64) CategoryYAxisOpt {
+	return func(ax *CategoryYAxis) {
+		ax.maxLabelWidth = width
+	}
+}
+
+// NewCategoryYAxis creates an axis drawing the labels of a category scale to the left of a plot, each centered on
+// its band. Long labels are truncated to the width set by CategoryMaxLabelWidth.
+func NewCategoryYAxis(scale *CategoryYScale, opts ...CategoryYAxisOpt) *CategoryYAxis {
+	y := &CategoryYAxis{
+		lineStyles: newThemeStyles(DefaultTheme.AxisStyles),
+		fontStyles: newThemeStyles(DefaultTheme.FontStyles),
+		scale:      scale,
+	}
+	for _, opt := range opts {
+		opt(y)
+	}
+	return y
+}
+
+type CategoryYAxis struct {
+	lineStyles    Styles
+	fontStyles    Styles
+	scale         *CategoryYScale
+	maxLabelWidth float64
+}
+
+// Scale returns the categories of the axis as a YScale (see CategoryYScale.ValueScale). Use CategoryScale to get
+// the scale the axis was created with.
+func (a *CategoryYAxis) Scale() YScale {
+	return a.scale.ValueScale()
+}
+
+func (a *CategoryYAxis) CategoryScale() *CategoryYScale {
+	return a.scale
+}
+
+func (a *CategoryYAxis) Render(canvas Renderer, b BoundingBox) error {
+	canvas.Push()
+	defer canvas.Pop()
+
+	a.lineStyles.styleOpts.Apply(canvas)
+
+	// vertical line
+	canvas.DrawLine(b.RelX(b.W), b.RelY(0), b.RelX(b.W), b.RelY(b.H))
+
+	bandHeight := a.scale.BandHeight(b)
+	textWidth := b.W - (defaultTickSize + defaultMargin)
+
+	for _, label := range a.scale.Labels() {
+
+		linePos := a.scale.Position(label.Tick, b) + bandHeight/2
+
+		canvas.DrawLine(b.RelX(b.W)-defaultTickSize, linePos, b.RelX(b.W), linePos)
+
+		canvas.Push()
+		a.fontStyles.styleOpts.Apply(canvas)
+		canvas.DrawStringAnchored(
+			truncateStringToMaxSize(canvas, label.Value, textWidth),
+			b.RelX(textWidth),
+			linePos,
+			1,
+			0.35,
+		)
+		canvas.Pop()
+	}
+	canvas.Stroke()
+
+	return nil
+}
+
+func (a *CategoryYAxis) width(canvas Renderer) float64 {
+	canvas.Push()
+	defer canvas.Pop()
+	a.fontStyles.styleOpts.Apply(canvas)
+
+	maxLabelW, _ := widestLabelSize(canvas, a.scale.Labels())
+	if a.maxLabelWidth > 0 {
+		maxLabelW = math.Min(maxLabelW, a.maxLabelWidth)
+	}
+	return maxLabelW + defaultTickSize + defaultMargin
+}
+
+type HorizontalValueAxisOpt func(ax *HorizontalValueAxis)
+
+func HorizontalValueFontStyles(opt ...style.Opt) HorizontalValueAxisOpt {
+	return func(ax *HorizontalValueAxis) {
+		ax.fontStyles.SetStyle(opt...)
+	}
+}
+
+func HorizontalValueLineStyles(opt ...style.Opt) HorizontalValueAxisOpt {
+	return func(ax *HorizontalValueAxis) {
+		ax.lineStyles.SetStyle(opt...)
+	}
+}
+
+// HorizontalValueTitle adds a title drawn below the labels.
+func HorizontalValueTitle(title string, opt ...style.Opt) HorizontalValueAxisOpt {
+	return func(ax *HorizontalValueAxis) {
+		ax.title.text = title
+		ax.title.styles.SetStyle(opt...)
+	}
+}
+
+// NewHorizontalValueAxis creates an axis drawing a value scale along the bottom of a plot, for use with
+// HorizontalBarsPlot.
+func NewHorizontalValueAxis(scale YScale, opts ...HorizontalValueAxisOpt) *HorizontalValueAxis {
+	x := &HorizontalValueAxis{
+		lineStyles: newThemeStyles(DefaultTheme.AxisStyles),
+		fontStyles: newThemeStyles(DefaultTheme.FontStyles),
+		scale:      scale,
+		title:      newAxisTitle(),
+	}
+	for _, o := range opts {
+		o(x)
+	}
+	return x
+}
+
+type HorizontalValueAxis struct {
+	lineStyles Styles
+	fontStyles Styles
+	scale      YScale
+	title      axisTitle
+}
+
+// Scale returns the value scale of the axis as a ContinuousXScale so it can be used where an XScale is expected.
+// Use ValueScale to get the scale the axis was created with.
+func (a *HorizontalValueAxis) Scale() XScale {
+	return &horizontalValueScale{scale: a.scale}
+}
+
+func (a *HorizontalValueAxis) ValueScale() YScale {
+	return a.scale
+}
+
+func (a *HorizontalValueAxis) Height(canvas Renderer) float64 {
+	return canvas.FontHeight() + defaultMargin + a.title.size(canvas)
+}
+
+func (a *HorizontalValueAxis) Render(canvas Renderer, b BoundingBox) error {
+	canvas.Push()
+	defer canvas.Pop()
+
+	a.lineStyles.styleOpts.Apply(canvas)
+
+	// horizontal line
+	canvas.DrawLine(b.RelX(0), b.RelY(0), b.RelX(b.W), b.RelY(0))
+
+	canvas.Push()
+	a.fontStyles.styleOpts.Apply(canvas)
+	labels := horizontalLabels(canvas, a.scale, b.W)
+	canvas.Pop()
+
+	for _, label := range labels {
+
+		linePos := horizontalPosition(a.scale, label.At, b)
+
+		tickSize := defaultTickSize
+		if label.Minor {
+			tickSize = defaultTickSize / 2
+		}
+		canvas.DrawLine(linePos, b.RelY(0), linePos, b.RelY(0)+tickSize)
+		if label.Minor {
+			continue
+		}
+
+		canvas.Push()
+		a.fontStyles.styleOpts.Apply(canvas)
+		// labels are kept within the axis rather than centered on ticks at the very edges.
+		w, _ := canvas.MeasureString(label.Value)
+		textPos := math.Max(math.Min(linePos, b.RelX(b.W)-w/2), b.RelX(0)+w/2)
+		canvas.DrawStringAnchored(label.Value, textPos, b.RelY(defaultTickSize), 0.5, 1)
+		canvas.Pop()
+	}
+
+	canvas.Stroke()
+
+	if titleSize := a.title.size(canvas); titleSize > 0 {
+		canvas.Push()
+		a.title.styles.styleOpts.Apply(canvas)
+		canvas.DrawStringAnchored(a.title.text, b.RelX(b.W/2), b.RelY(b.H-titleSize/2), 0.5, 0.35)
+		canvas.Pop()
+	}
+
+	return nil
+}
+
+// horizontalLabels returns the labels of a value scale drawn along the given width. If the labels are too wide to
+// fit next to each other only every n-th label is kept.
+func horizontalLabels(canvas Renderer, scale YScale, width float64) []Label {
+	labels := fitYLabels(scale, width)
+	if len(labels) < 2 {
+		return labels
+	}
+	maxLabelW, _ := widestLabelSize(canvas, labels)
+	every := int(math.Ceil((maxLabelW + defaultMargin*2) / (width / float64(len(labels)-1))))
+	if every <= 1 {
+		return labels
+	}
+	kept := []Label{}
+	for k, label := range labels {
+		if k%every == 0 {
+			kept = append(kept, label)
+		}
+	}
+	return kept
+}
@@ -0,0 +1,206 @@
+package gochart
+
+import (
+	"math"
+	"sort"
+
+	"github.com/warmans/gochart/pkg/style"
+)
+
+// WhiskerRule decides how far the whiskers of a box plot extend.
+type WhiskerRule int
+
+const (
+	// WhiskerTukey extends the whiskers to the furthest samples within 1.5 times the interquartile range of the box.
+	// Samples beyond the whiskers are drawn as outliers.
+	WhiskerTukey WhiskerRule = iota
+	// WhiskerMinMax extends the whiskers to the smallest and largest samples.
+	WhiskerMinMax
+)
+
+// BoxStats summarises the distribution of a set of samples.
+type BoxStats struct {
+	Q1           float64
+	Median       float64
+	Q3           float64
+	LowerWhisker float64
+	UpperWhisker float64
+	Outliers     []float64
+}
+
+// NewBoxStats calculates the quartiles, whiskers and outliers of the samples.
+func NewBoxStats(samples []float64, rule WhiskerRule) BoxStats {
+	sorted := make([]float64, 0, len(samples))
+	for _, v := range samples {
+		if !math.IsNaN(v) {
+			sorted = append(sorted, v)
+		}
+	}
+	if len(sorted) == 0 {
+		return BoxStats{}
+	}
+	sort.Float64s(sorted)
+
+	stats := BoxStats{
+		Q1:           quantile(sorted, 0.25),
+		Median:       quantile(sorted, 0.5),
+		Q3:           quantile(sorted, 0.75),
+		LowerWhisker: sorted[0],
+		UpperWhisker: sorted[len(sorted)-1],
+	}
+	if rule == WhiskerMinMax {
+		return stats
+	}
+
+	iqr := stats.Q3 - stats.Q1
+	lowerFence, upperFence := stats.Q1-1.5*iqr, stats.Q3+1.5*iqr
+	stats.LowerWhisker, stats.UpperWhisker = stats.Q1, stats.Q3
+	for _, v := range sorted {
+		if v < lowerFence || v > upperFence {
+			stats.Outliers = append(stats.Outliers, v)
+			continue
+		}
+		stats.LowerWhisker = math.Min(stats.LowerWhisker, v)
+		stats.UpperWhisker = math.Max(stats.UpperWhisker, v)
+	}
+	return stats
+}
+
+// BoxWhiskers sets the rule used for the whiskers of a BoxPlot. The default is WhiskerTukey.
+func BoxWhiskers(rule WhiskerRule) PlotOpt {
+	return func(p Plot) {
+		if box, ok := p.(*BoxPlot); ok {
+			box.whiskers = rule
+			box.calculate()
+		}
+	}
+}
+
+// BoxLineStyles sets the style of the outline, median and whiskers of a BoxPlot.
+func BoxLineStyles(opt ...style.Opt) PlotOpt {
+	return func(p Plot) {
+		if box, ok := p.(*BoxPlot); ok {
+			box.lineStyles.SetStyle(opt...)
+		}
+	}
+}
+
+// BoxOutlierStyles sets the style of the outliers of a BoxPlot.
+func BoxOutlierStyles(opt ...style.Opt) PlotOpt {
+	return func(p Plot) {
+		if box, ok := p.(*BoxPlot); ok {
+			box.outlierStyles.SetStyle(opt...)
+		}
+	}
+}
+
+// NewSamplesSeries creates a series of every sample e.g. to create a Y scale that covers all the boxes of a
+// BoxPlot.
+func NewSamplesSeries(samples [][]float64) Series {
+	values := []float64{}
+	for _, s := range samples {
+		values = append(values, s...)
+	}
+	return NewYSeries(values)
+}
+
+// NewBoxPlot creates a box and whisker plot with one box per category of the X scale. Each element of samples is the
+// raw samples of a category. The plot's style sets the fill of the boxes.
+func NewBoxPlot(yScale YScale, xScale XScale, samples [][]float64, opts ...PlotOpt) *BoxPlot {
+	p := &BoxPlot{
+		Styles:        defaultPlotStyles(),
+		lineStyles:    newThemeStyles(DefaultTheme.FontStyles),
+		outlierStyles: newThemeStyles(DefaultTheme.FontStyles),
+		yScale:        yScale,
+		xScale:        xScale,
+		samples:       samples,
+	}
+	p.calculate()
+	for _, o := range opts {
+		o(p)
+	}
+	return p
+}
+
+type BoxPlot struct {
+	Styles
+	lineStyles    Styles
+	outlierStyles Styles
+	yScale        YScale
+	xScale        XScale
+	samples       [][]float64
+	whiskers      WhiskerRule
+	stats         []BoxStats
+}
+
+// Stats returns the summary of each category.
+func (c *BoxPlot) Stats() []BoxStats {
+	return c.stats
+}
+
+func (c *BoxPlot) Render(canvas Renderer, b BoundingBox) error {
+
+	canvas.Push()
+	defer canvas.Pop()
+
+	tickWidth := math.Max(b.W/float64(c.xScale.NumTicks())-defaultMargin, 1)
+	boxWidth := tickWidth / 2
+
+	for i, s := range c.stats {
+		if len(c.samples[i]) == 0 {
+			continue
+		}
+		center := c.xScale.Position(i, b) + tickWidth/2
+		left := center - boxWidth/2
+
+		q1, q3 := c.yScale.Position(s.Q1, b), c.yScale.Position(s.Q3, b)
+		lower, upper := c.yScale.Position(s.LowerWhisker, b), c.yScale.Position(s.UpperWhisker, b)
+		median := c.yScale.Position(s.Median, b)
+
+		canvas.Push()
+		c.styleOpts.Apply(canvas)
+		canvas.DrawRectangle(left, q3, boxWidth, q1-q3)
+		canvas.Fill()
+		canvas.Pop()
+
+		canvas.Push()
+		c.lineStyles.styleOpts.Apply(canvas)
+		canvas.DrawRectangle(left, q3, boxWidth, q1-q3)
+		canvas.DrawLine(left, median, left+boxWidth, median)
+		canvas.DrawLine(center, q3, center, upper)
+		canvas.DrawLine(center, q1, center, lower)
+		canvas.DrawLine(center-boxWidth/4, upper, center+boxWidth/4, upper)
+		canvas.DrawLine(center-boxWidth/4, lower, center+boxWidth/4, lower)
+		canvas.Stroke()
+		canvas.Pop()
+
+		canvas.Push()
+		c.outlierStyles.styleOpts.Apply(canvas)
+		for _, v := range s.Outliers {
+			canvas.DrawCircle(center, c.yScale.Position(v, b), 2)
+			canvas.Stroke()
+		}
+		canvas.Pop()
+	}
+
+	return nil
+}
+
+// ReplaceSeries does nothing as the plot is drawn from samples rather than a series.
+func (c *BoxPlot) ReplaceSeries(fn func(s Series) Series) {
+}
+
+func (c *BoxPlot) ReplaceYScale(fn func(s YScale) YScale) {
+	c.yScale = fn(c.YScale())
+}
+
+func (c *BoxPlot) YScale() YScale {
+	return c.yScale
+}
+
+func (c *BoxPlot) calculate() {
+	c.stats = make([]BoxStats, len(c.samples))
+	for k, s := range c.samples {
+		c.stats[k] = NewBoxStats(s, c.whiskers)
+	}
+}
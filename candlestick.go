@@ -0,0 +1,165 @@
+package gochart
+
+import (
+	"image/color"
+	"math"
+
+	"github.com/warmans/gochart/pkg/style"
+)
+
+// the proportion of the space between points taken up by each candle.
+const candleWidthRatio = 0.6
+
+// CandleUpStyles sets the style of the periods of a CandlestickPlot where the close is at or above the open.
+func CandleUpStyles(opt ...style.Opt) PlotOpt {
+	return func(p Plot) {
+		if c, ok := p.(*CandlestickPlot); ok {
+			c.upStyles.SetStyle(opt...)
+		}
+	}
+}
+
+// CandleDownStyles sets the style of the periods of a CandlestickPlot where the close is below the open.
+func CandleDownStyles(opt ...style.Opt) PlotOpt {
+	return func(p Plot) {
+		if c, ok := p.(*CandlestickPlot); ok {
+			c.downStyles.SetStyle(opt...)
+		}
+	}
+}
+
+// NewCandlestickPlot creates a plot drawing each period of the series as a candle with a body between the open and
+// close and a wick from the low to the high. Use NewRangeYScale to fit the Y scale to the highs and lows.
+func NewCandlestickPlot(yScale YScale, xScale XScale, s *OHLCSeries, opts ...PlotOpt) *CandlestickPlot {
+	return newCandlestickPlot(yScale, xScale, s, false, opts...)
+}
+
+// NewOHLCPlot creates a plot drawing each period of the series as a bar from the low to the high with a tick to the
+// left at the open and a tick to the right at the close.
+func NewOHLCPlot(yScale YScale, xScale XScale, s *OHLCSeries, opts ...PlotOpt) *CandlestickPlot {
+	return newCandlestickPlot(yScale, xScale, s, true, opts...)
+}
+
+func newCandlestickPlot(yScale YScale, xScale XScale, s *OHLCSeries, bars bool, opts ...PlotOpt) *CandlestickPlot {
+	p := &CandlestickPlot{
+		Styles:     NewStyles(style.LineWidth(1)),
+		upStyles:   NewStyles(style.Color(color.RGBA{R: 38, G: 166, B: 91, A: 255})),
+		downStyles: NewStyles(style.Color(color.RGBA{R: 214, G: 69, B: 65, A: 255})),
+		yScale:     yScale,
+		xScale:     xScale,
+		s:          s,
+		bars:       bars,
+	}
+	for _, o := range opts {
+		o(p)
+	}
+	return p
+}
+
+type CandlestickPlot struct {
+	Styles
+	upStyles   Styles
+	downStyles Styles
+	yScale     YScale
+	xScale     XScale
+	s          *OHLCSeries
+	bars       bool
+}
+
+func (c *CandlestickPlot) Render(canvas Renderer, b BoundingBox) error {
+
+	canvas.Push()
+	defer canvas.Pop()
+
+	c.styleOpts.Apply(canvas)
+
+	numPoints := len(c.s.values)
+	tickWidth := b.W/float64(numPoints) - defaultMargin
+
+	centers := make([]float64, numPoints)
+	for i := range centers {
+		centers[i] = xPosition(c.xScale, c.s, i, tickWidth, b)
+	}
+	width := math.Max(c.candleSpacing(centers, tickWidth)*candleWidthRatio, 1)
+
+	for i, v := range c.s.values {
+		if math.IsNaN(v.Open) || math.IsNaN(v.High) || math.IsNaN(v.Low) || math.IsNaN(v.Close) {
+			continue
+		}
+		canvas.Push()
+		if v.Close >= v.Open {
+			c.upStyles.styleOpts.Apply(canvas)
+		} else {
+			c.downStyles.styleOpts.Apply(canvas)
+		}
+
+		center := centers[i]
+		open, close := c.yScale.Position(v.Open, b), c.yScale.Position(v.Close, b)
+		high, low := c.yScale.Position(v.High, b), c.yScale.Position(v.Low, b)
+
+		if c.bars {
+			canvas.DrawLine(center, high, center, low)
+			canvas.DrawLine(center-width/2, open, center, open)
+			canvas.DrawLine(center, close, center+width/2, close)
+			canvas.Stroke()
+			canvas.Pop()
+			continue
+		}
+
+		// the wick is drawn either side of the body so it does not show through when the body is not filled.
+		top, bottom := math.Min(open, close), math.Max(open, close)
+		canvas.DrawLine(center, high, center, top)
+		canvas.DrawLine(center, bottom, center, low)
+		canvas.Stroke()
+
+		if bottom-top < 1 {
+			// the open and close are the same so there is no body to fill.
+			canvas.DrawLine(center-width/2, top, center+width/2, top)
+			canvas.Stroke()
+		} else {
+			canvas.DrawRectangle(center-width/2, top, width, bottom-top)
+			canvas.Fill()
+		}
+		canvas.Pop()
+	}
+
+	return nil
+}
+
+// candleSpacing finds the smallest distance between adjacent points so candles never overlap on continuous scales
+// where points may be unevenly spaced.
+func (c *CandlestickPlot) candleSpacing(centers []float64, tickWidth float64) float64 {
+	if _, ok := c.xScale.(ContinuousXScale); !ok || len(centers) < 2 {
+		return tickWidth
+	}
+	spacing := math.Inf(1)
+	for i := 1; i < len(centers); i++ {
+		if d := math.Abs(centers[i] - centers[i-1]); d > 0 {
+			spacing = math.Min(spacing, d)
+		}
+	}
+	if math.IsInf(spacing, 0) {
+		return tickWidth
+	}
+	return spacing
+}
+
+// styles is the style of the up periods so a legend entry for the plot matches the rising candles.
+func (c *CandlestickPlot) styles() style.Opts {
+	return append(append(style.Opts{}, c.styleOpts...), c.upStyles.styleOpts...)
+}
+
+// ReplaceSeries only accepts an OHLCSeries. Other series are ignored as they do not have the values to draw candles.
+func (c *CandlestickPlot) ReplaceSeries(fn func(s Series) Series) {
+	if s, ok := fn(c.s).(*OHLCSeries); ok {
+		c.s = s
+	}
+}
+
+func (c *CandlestickPlot) ReplaceYScale(fn func(s YScale) YScale) {
+	c.yScale = fn(c.YScale())
+}
+
+func (c *CandlestickPlot) YScale() YScale {
+	return c.yScale
+}
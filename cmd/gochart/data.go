@@ -0,0 +1,130 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+
+	"github.com/warmans/gochart"
+)
+
+func readTable(r io.Reader, input, header string) (*gochart.DataTable, error) {
+	var opts []gochart.LoadOpt
+	switch header {
+	case "auto":
+	case "yes":
+		opts = append(opts, gochart.LoadHeader(gochart.HeaderPresent))
+	case "no":
+		opts = append(opts, gochart.LoadHeader(gochart.HeaderAbsent))
+	default:
+		return nil, fmt.Errorf("unknown header mode %q: expected auto, yes or no", header)
+	}
+
+	switch input {
+	case "csv":
+		return gochart.LoadCSV(r, opts...)
+	case "tsv":
+		return gochart.LoadCSV(r, append(opts, gochart.LoadComma('\t'))...)
+	case "json":
+		return gochart.LoadJSON(r, opts...)
+	}
+	return nil, fmt.Errorf("unknown input format %q: expected csv, tsv or json", input)
+}
+
+// column selects a column by index if the flag is a number and by name otherwise.
+func column(s string) gochart.Column {
+	if i, err := strconv.Atoi(s); err == nil {
+		return gochart.ColumnIndex(i)
+	}
+	return gochart.ColumnName(s)
+}
+
+// tableSeries creates a series for each of the Y columns (or every column other than X) with X values from the X
+// column. The X values are parsed by the spec to suit the X scale. A table with a single column has values but no
+// X values.
+func tableSeries(t *gochart.DataTable, xCol string, yCols []string) ([]gochart.SeriesSpec, error) {
+	if t.Len() == 0 {
+		return nil, fmt.Errorf("no data")
+	}
+
+	var x []string
+	xIdx := -1
+	if xCol != "" || t.NumColumns() > 1 {
+		if xCol == "" {
+			xCol = "0"
+		}
+		var err error
+		if xIdx, err = t.Index(column(xCol)); err != nil {
+			return nil, err
+		}
+		if x, err = t.Strings(column(xCol)); err != nil {
+			return nil, err
+		}
+	}
+
+	var cols []int
+	for _, c := range yCols {
+		idx, err := t.Index(column(c))
+		if err != nil {
+			return nil, err
+		}
+		cols = append(cols, idx)
+	}
+	if len(yCols) == 0 {
+		for k := 0; k < t.NumColumns(); k++ {
+			if k != xIdx {
+				cols = append(cols, k)
+			}
+		}
+	}
+
+	var errs gochart.LoadErrors
+	series := make([]gochart.SeriesSpec, len(cols))
+	names := map[string]int{}
+	for k, idx := range cols {
+		values, err := t.Values(gochart.ColumnIndex(idx))
+		if loadErrs, ok := err.(gochart.LoadErrors); ok {
+			errs = append(errs, loadErrs...)
+		} else if err != nil {
+			return nil, err
+		}
+		text, err := t.Strings(gochart.ColumnIndex(idx))
+		if err != nil {
+			return nil, err
+		}
+
+		name := fmt.Sprintf("series %d", k+1)
+		if idx < len(t.Header()) && t.Header()[idx] != "" {
+			name = t.Header()[idx]
+		}
+		// plots find their series by name so columns with the same name are numbered.
+		names[name]++
+		if names[name] > 1 {
+			name = fmt.Sprintf("%s (%d)", name, names[name])
+		}
+
+		series[k] = gochart.SeriesSpec{Name: name, X: x}
+		for row, v := range values {
+			if math.Abs(v) > gochart.MaxSpecValue {
+				errs = append(errs, gochart.RowError{
+					Row:    t.RowNumber(row),
+					Column: name,
+					Value:  text[row],
+					Msg:    fmt.Sprintf("%q is too large to plot: values must be between %g and %g", text[row], -gochart.MaxSpecValue, gochart.MaxSpecValue),
+				})
+				v = math.NaN()
+			}
+			if math.IsNaN(v) {
+				series[k].Y = append(series[k].Y, nil)
+				continue
+			}
+			v := v
+			series[k].Y = append(series[k].Y, &v)
+		}
+	}
+	if len(errs) > 0 {
+		return nil, errs
+	}
+	return series, nil
+}
@@ -0,0 +1,243 @@
+// Command gochart renders a chart from a CSV, TSV or JSON data file or from a chart spec.
+//
+//	gochart -type bars -title "Requests" -o requests.png requests.csv
+//	cat metrics.tsv | gochart -input tsv -x time -format svg > metrics.svg
+//	gochart -spec dashboard.yaml -o dashboard.pdf
+//
+// The first column of the data is used for the X values and each of the other columns is drawn as a series (see
+// -xcol and -ycols). The first row is used for the names of the series if it is not numeric. Empty values and null
+// are missing values.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/warmans/gochart"
+)
+
+type options struct {
+	spec       string
+	input      string
+	header     string
+	xCol       string
+	yCols      string
+	chartType  string
+	mode       string
+	xScale     string
+	timeLayout string
+	yScale     string
+	title      string
+	subtitle   string
+	xTitle     string
+	yTitle     string
+	width      int
+	height     int
+	theme      string
+	palette    string
+	legend     bool
+	output     string
+	format     string
+}
+
+func main() {
+	opts := options{}
+	flag.StringVar(&opts.spec, "spec", "", "read a chart spec (JSON or YAML) from this file instead of data, - for stdin")
+	flag.StringVar(&opts.input, "input", "", "format of the data: csv, tsv or json (default from the file extension or csv)")
+	flag.StringVar(&opts.header, "header", "auto", "whether the first row of the data is a header: auto, yes or no")
+	flag.StringVar(&opts.xCol, "xcol", "", "column of the X values by name or index (default the first column)")
+	flag.StringVar(&opts.yCols, "ycols", "", "comma separated columns to draw by name or index (default every column other than X)")
+	flag.StringVar(&opts.chartType, "type", "lines", "chart type: lines, points, bars, area, pie or donut")
+	flag.StringVar(&opts.mode, "mode", "", "how series are combined: overlay, stack or group")
+	flag.StringVar(&opts.xScale, "x", "", "X scale: category, linear or time")
+	flag.StringVar(&opts.timeLayout, "time-layout", "", "Go time layout of X values for time scales (default RFC 3339)")
+	flag.StringVar(&opts.yScale, "y", "", "Y scale: linear, range or log")
+	flag.StringVar(&opts.title, "title", "", "chart title")
+	flag.StringVar(&opts.subtitle, "subtitle", "", "chart subtitle")
+	flag.StringVar(&opts.xTitle, "xtitle", "", "X axis title")
+	flag.StringVar(&opts.yTitle, "ytitle", "", "Y axis title")
+	flag.IntVar(&opts.width, "width", gochart.DefaultSpecWidth, "width in pixels")
+	flag.IntVar(&opts.height, "height", gochart.DefaultSpecHeight, "height in pixels")
+	flag.StringVar(&opts.theme, "theme", "", "theme: default or dark")
+	flag.StringVar(&opts.palette, "palette", "", "palette: categorical, colorblind or high_contrast")
+	flag.BoolVar(&opts.legend, "legend", true, "draw a legend when there is more than one series")
+	flag.StringVar(&opts.output, "o", "-", "output file, - for stdout")
+	flag.StringVar(&opts.format, "format", "", "output format: png, svg or pdf (default from the output file extension or png)")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: gochart [flags] [data file]\n\nReads data from stdin if no file is given.\n\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if err := run(opts, flag.Arg(0), setFlags()); err != nil {
+		switch errs := err.(type) {
+		case gochart.SpecErrors:
+			for _, e := range errs {
+				fmt.Fprintf(os.Stderr, "gochart: %s\n", e)
+			}
+		case gochart.LoadErrors:
+			for _, e := range errs {
+				fmt.Fprintf(os.Stderr, "gochart: %s\n", e)
+			}
+		default:
+			fmt.Fprintf(os.Stderr, "gochart: %s\n", err)
+		}
+		os.Exit(1)
+	}
+}
+
+// setFlags are the flags given on the command line so they can override the values of a spec.
+func setFlags() map[string]bool {
+	set := map[string]bool{}
+	flag.Visit(func(f *flag.Flag) {
+		set[f.Name] = true
+	})
+	return set
+}
+
+func run(opts options, dataPath string, set map[string]bool) error {
+	format := gochart.SpecFormat(opts.format)
+	if format == "" {
+		format = gochart.FormatPNG
+		if ext := strings.TrimPrefix(filepath.Ext(opts.output), "."); ext != "" && opts.output != "-" {
+			format = gochart.SpecFormat(strings.ToLower(ext))
+		}
+	}
+
+	var spec *gochart.Spec
+	var err error
+	if opts.spec != "" {
+		spec, err = readSpec(opts, set)
+	} else {
+		spec, err = dataSpec(opts, dataPath)
+	}
+	if err != nil {
+		return err
+	}
+
+	// the chart is rendered before the output is created so a failure does not leave an empty file.
+	buf := &bytes.Buffer{}
+	if err := spec.Encode(buf, format); err != nil {
+		return err
+	}
+	if opts.output == "-" {
+		_, err := io.Copy(os.Stdout, buf)
+		return err
+	}
+	return ioutil.WriteFile(opts.output, buf.Bytes(), 0644)
+}
+
+func open(path string) (io.ReadCloser, error) {
+	if path == "" || path == "-" {
+		return ioutil.NopCloser(os.Stdin), nil
+	}
+	return os.Open(path)
+}
+
+// readSpec reads a spec file. Flags given on the command line replace the values in the spec.
+func readSpec(opts options, set map[string]bool) (*gochart.Spec, error) {
+	f, err := open(opts.spec)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	spec, err := gochart.DecodeSpec(f)
+	if err != nil {
+		return nil, err
+	}
+	if set["width"] {
+		spec.Width = opts.width
+	}
+	if set["height"] {
+		spec.Height = opts.height
+	}
+	if set["theme"] {
+		spec.Theme = opts.theme
+	}
+	if set["palette"] {
+		spec.Palette = opts.palette
+	}
+	if set["title"] {
+		spec.Title = opts.title
+	}
+	if set["subtitle"] {
+		spec.Subtitle = opts.subtitle
+	}
+	return spec, nil
+}
+
+// dataSpec reads a data file and creates a spec drawing each of its series.
+func dataSpec(opts options, path string) (*gochart.Spec, error) {
+	input := opts.input
+	if input == "" {
+		input = "csv"
+		switch strings.ToLower(filepath.Ext(path)) {
+		case ".tsv", ".tab":
+			input = "tsv"
+		case ".json":
+			input = "json"
+		}
+	}
+
+	f, err := open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	t, err := readTable(f, input, opts.header)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", input, err)
+	}
+
+	var yCols []string
+	if opts.yCols != "" {
+		yCols = strings.Split(opts.yCols, ",")
+	}
+	series, err := tableSeries(t, opts.xCol, yCols)
+	if err != nil {
+		return nil, err
+	}
+	if len(series) == 0 {
+		return nil, fmt.Errorf("no series found: the data needs a column of values after the X column")
+	}
+
+	chart := &gochart.ChartSpec{
+		Mode: opts.mode,
+		X:    gochart.XScaleSpec{Type: opts.xScale, Title: opts.xTitle},
+		Y:    gochart.YScaleSpec{Type: opts.yScale, Title: opts.yTitle},
+	}
+	switch opts.chartType {
+	case "pie", "donut":
+		// a pie only draws the first series.
+		chart.Type = opts.chartType
+		chart.Legend = opts.legend
+		chart.Plots = []gochart.PlotSpec{{Series: series[0].Name}}
+	default:
+		chart.Legend = opts.legend && len(series) > 1
+		for _, s := range series {
+			chart.Plots = append(chart.Plots, gochart.PlotSpec{Type: opts.chartType, Series: s.Name})
+		}
+	}
+	for k := range series {
+		series[k].TimeLayout = opts.timeLayout
+	}
+
+	return &gochart.Spec{
+		Width:    opts.width,
+		Height:   opts.height,
+		Theme:    opts.theme,
+		Palette:  opts.palette,
+		Title:    opts.title,
+		Subtitle: opts.subtitle,
+		Series:   series,
+		Chart:    chart,
+	}, nil
+}
@@ -2,9 +2,9 @@ package gochart
 
 import (
 	"math"
+	"strconv"
 	"time"
 
-	"github.com/fogleman/gg"
 	"github.com/warmans/gochart/pkg/style"
 )
 
@@ -12,38 +12,82 @@ const defaultMargin float64 = 8
 const defaultTickSize float64 = 4
 
 type Renderable interface {
-	Render(canvas *gg.Context, container BoundingBox) error
+	Render(canvas Renderer, container BoundingBox) error
 }
 
 func NewStyles(defaults ...style.Opt) Styles {
-	return Styles{styleOpts: defaults}
+	return Styles{opts: defaults, styleOpts: defaults}
+}
+
+// newThemeStyles creates styles with defaults from a theme. They are replaced if another theme is applied while any
+// options set with SetStyle are kept.
+func newThemeStyles(theme style.Opts) Styles {
+	s := Styles{}
+	s.setTheme(theme)
+	return s
 }
 
 type Styles struct {
+	themeOpts style.Opts
+	opts      style.Opts
+	// styleOpts are the theme options followed by the options that were set explicitly.
 	styleOpts style.Opts
 }
 
 func (a *Styles) SetStyle(opt ...style.Opt) {
-	a.styleOpts = append(a.styleOpts, opt...)
+	a.opts = append(a.opts, opt...)
+	a.update()
+}
+
+func (a *Styles) setTheme(theme style.Opts) {
+	a.themeOpts = theme
+	a.update()
+}
+
+func (a *Styles) update() {
+	a.styleOpts = append(append(style.Opts{}, a.themeOpts...), a.opts...)
+}
+
+func (a *Styles) styles() style.Opts {
+	return a.styleOpts
 }
 
 // simply find the min and max numbers in the given
-// slices.
+// slices. The range always includes zero so values
+// are measured from a zero baseline.
 func floatsRange(vv [][]float64) (float64, float64) {
+	overallMin := 0.0
 	overallMax := 0.0
-	overallMin := -1.0
 	for _, v := range vv {
 		min, max := floatRange(v)
-		if overallMin == -1 || min < overallMin {
-			overallMin = min
-		}
-		if max > overallMax {
-			overallMax = max
-		}
+		overallMin = math.Min(overallMin, min)
+		overallMax = math.Max(overallMax, max)
 	}
 	return overallMin, overallMax
 }
 
+// floatsBounds finds the min and max numbers in the given slices without extending the range to include zero. NaN
+// values are ignored.
+func floatsBounds(vv [][]float64) (float64, float64) {
+	min, max := math.Inf(1), math.Inf(-1)
+	for _, v := range vv {
+		for _, f := range v {
+			if math.IsNaN(f) {
+				continue
+			}
+			min = math.Min(min, f)
+			max = math.Max(max, f)
+		}
+	}
+	if math.IsInf(min, 0) {
+		return 0, 1
+	}
+	if min == max {
+		return min - 1, max + 1
+	}
+	return min, max
+}
+
 func additiveFloatMerge(slices [][]float64) []float64 {
 	res := []float64{}
 	for _, sl := range slices {
@@ -58,18 +102,22 @@ func additiveFloatMerge(slices [][]float64) []float64 {
 	return res
 }
 
+// floatRange finds the min and max of the values including zero. NaN values are missing so they are ignored.
 func floatRange(v []float64) (float64, float64) {
 	max := 0.0
-	min := -1.0
+	min := 0.0
 	for _, v := range v {
+		if math.IsNaN(v) {
+			continue
+		}
 		if v > max {
 			max = v
 		}
-		if min == -1 || v < min {
+		if v < min {
 			min = v
 		}
 	}
-	return math.Max(min, 0), max
+	return min, max
 }
 
 func timeRange(v []time.Time) (time.Time, time.Time) {
@@ -86,7 +134,7 @@ func timeRange(v []time.Time) (time.Time, time.Time) {
 	return min, max
 }
 
-func BoundingBoxFromCanvas(ctx *gg.Context) BoundingBox {
+func BoundingBoxFromCanvas(ctx Renderer) BoundingBox {
 	return BoundingBox{
 		X: 10,
 		Y: 10,
@@ -96,10 +144,10 @@ func BoundingBoxFromCanvas(ctx *gg.Context) BoundingBox {
 }
 
 func normalizeToRange(val, valMin, valMax, scaleMin, scaleMax float64) float64 {
-	return (((val - valMin) / valMax) * scaleMax) + scaleMin
+	return (((val - valMin) / (valMax - valMin)) * (scaleMax - scaleMin)) + scaleMin
 }
 
-func truncateStringToMaxSize(canvas *gg.Context, s string, size float64) string {
+func truncateStringToMaxSize(canvas Renderer, s string, size float64) string {
 	for {
 		if len([]rune(s)) < 1 {
 			return ""
@@ -113,13 +161,17 @@ func truncateStringToMaxSize(canvas *gg.Context, s string, size float64) string
 	}
 }
 
-func reduceNumLabelsToFitSpace(canvas *gg.Context, ss []Label, size float64) []Label {
+func reduceNumLabelsToFitSpace(canvas Renderer, ss []Label, size float64) []Label {
+	if size <= 0 {
+		return []Label{}
+	}
 	for {
 		// actually none fit
 		if len(ss) == 0 {
 			return ss
 		}
-		if totalLabelsWidth(canvas, ss, defaultMargin*2) <= size {
+		// a single label cannot be reduced any further even if it is too wide.
+		if len(ss) == 1 || totalLabelsWidth(canvas, ss, defaultMargin*2) <= size {
 			return ss
 		}
 
@@ -136,7 +188,7 @@ func reduceNumLabelsToFitSpace(canvas *gg.Context, ss []Label, size float64) []L
 	}
 }
 
-func totalLabelsWidth(canvas *gg.Context, ss []Label, margins float64) float64 {
+func totalLabelsWidth(canvas Renderer, ss []Label, margins float64) float64 {
 	total := 0.0
 	for _, v := range ss {
 		w, _ := canvas.MeasureString(v.Value)
@@ -145,7 +197,7 @@ func totalLabelsWidth(canvas *gg.Context, ss []Label, margins float64) float64 {
 	return total
 }
 
-func widestLabelSize(canvas *gg.Context, ss []Label) (w float64, h float64) {
+func widestLabelSize(canvas Renderer, ss []Label) (w float64, h float64) {
 	for _, s := range ss {
 		ww, hh := canvas.MeasureString(s.Value)
 		if ww > w {
@@ -156,6 +208,49 @@ func widestLabelSize(canvas *gg.Context, ss []Label) (w float64, h float64) {
 	return
 }
 
+// quantile returns the q-th quantile (between 0 and 1) of the sorted values using linear interpolation between the
+// closest ranks.
+func quantile(sorted []float64, q float64) float64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+	pos := q * float64(len(sorted)-1)
+	lower := int(math.Floor(pos))
+	upper := int(math.Ceil(pos))
+	return sorted[lower] + (sorted[upper]-sorted[lower])*(pos-float64(lower))
+}
+
+// interpolateNaN returns a copy of the values with each NaN replaced by a linear interpolation between the values
+// either side, using x to find the X value of each index. NaN values at the start or end are left as they are.
+func interpolateNaN(values []float64, x func(i int) float64) []float64 {
+	filled := make([]float64, len(values))
+	copy(filled, values)
+
+	previous := -1
+	for i, v := range values {
+		if math.IsNaN(v) {
+			continue
+		}
+		if previous >= 0 && i-previous > 1 {
+			x0, x1 := x(previous), x(i)
+			for k := previous + 1; k < i; k++ {
+				if x1 == x0 {
+					filled[k] = values[previous]
+					continue
+				}
+				filled[k] = values[previous] + (v-values[previous])*(x(k)-x0)/(x1-x0)
+			}
+		}
+		previous = i
+	}
+	return filled
+}
+
+// formatFloat formats the number with up to two decimal places, dropping any trailing zeros.
+func formatFloat(v float64) string {
+	return strconv.FormatFloat(math.Round(v*100)/100, 'f', -1, 64)
+}
+
 func minInt64(a, b int64) int64 {
 	if a > b {
 		return b
@@ -174,10 +269,21 @@ func allYData(series []Series) [][]float64 {
 	all := make([][]float64, 0)
 	for _, s := range series {
 		all = append(all, s.Ys())
+		if rs, ok := s.(RangeSeries); ok {
+			all = append(all, rs.Lows(), rs.Highs())
+		}
 	}
 	return all
 }
 
+func timeToUnixSeconds(t time.Time) float64 {
+	return float64(t.UnixNano()) / float64(time.Second)
+}
+
+func unixSecondsToTime(v float64) time.Time {
+	return time.Unix(0, int64(math.Round(v*float64(time.Second))))
+}
+
 func TimeSeriesDuration(s []time.Time) time.Duration {
 	min, max := timeRange(s)
 	return max.Sub(min)
@@ -0,0 +1,29 @@
+package gochart
+
+import "testing"
+
+func TestReduceNumLabelsToFitSpaceTinyBox(t *testing.T) {
+	canvas := NewSVGRenderer(1, 1)
+	labels := []Label{{Value: "first"}, {Value: "second"}, {Value: "third"}}
+
+	if got := reduceNumLabelsToFitSpace(canvas, labels, 1); len(got) != 1 {
+		t.Fatalf("expected one label, got %d", len(got))
+	}
+	if got := reduceNumLabelsToFitSpace(canvas, labels, 0); len(got) != 0 {
+		t.Fatalf("expected no labels, got %d", len(got))
+	}
+	if got := reduceNumLabelsToFitSpace(canvas, labels, -10); len(got) != 0 {
+		t.Fatalf("expected no labels, got %d", len(got))
+	}
+}
+
+func TestRenderTinyLayout(t *testing.T) {
+	series := NewXYSeries([]string{"first", "second", "third"}, []float64{1, 2, 3})
+	yScale := NewYScale(AutoTicks, series)
+	xScale := NewXScale(series, 0)
+	layout := NewDynamicLayout(NewStdYAxis(yScale), NewStdXAxis(series, xScale), NewLinesPlot(yScale, xScale, series))
+
+	if err := layout.Render(NewSVGRenderer(1, 1), BoundingBox{W: 1, H: 1}); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"image/color"
+	"math"
+
+	"github.com/fogleman/gg"
+	"github.com/warmans/gochart"
+	"github.com/warmans/gochart/pkg/style"
+)
+
+const numPoints = 48
+
+func main() {
+
+	canvas := gg.NewContext(800, 600)
+	canvas.SetColor(color.White)
+	canvas.DrawRectangle(0, 0, float64(canvas.Width()), float64(canvas.Height()))
+	canvas.Fill()
+
+	cpu := make([]float64, numPoints)
+	memory := make([]float64, numPoints)
+	disk := make([]float64, numPoints)
+	for i := 0; i < numPoints; i++ {
+		cpu[i] = 20 + 10*math.Sin(float64(i)/5)
+		memory[i] = 15 + 5*math.Cos(float64(i)/7)
+		disk[i] = 5 + 4*math.Sin(float64(i)/3)
+	}
+	cpuSeries := gochart.NewYSeries(cpu)
+	memorySeries := gochart.NewYSeries(memory)
+	diskSeries := gochart.NewYSeries(disk)
+
+	xScale := gochart.NewXScale(cpuSeries, 0)
+
+	// Stacked semi-transparent areas
+	stackedPlots, stackedScale := gochart.StackPlots(
+		gochart.NewAreaPlot(gochart.NewYScale(gochart.AutoTicks, cpuSeries), xScale, cpuSeries, gochart.PlotStyle(style.Color(color.RGBA{R: 136, G: 46, B: 46, A: 200}))),
+		gochart.NewAreaPlot(gochart.NewYScale(gochart.AutoTicks, memorySeries), xScale, memorySeries, gochart.PlotStyle(style.Color(color.RGBA{R: 36, G: 109, B: 36, A: 200}))),
+		gochart.NewAreaPlot(gochart.NewYScale(gochart.AutoTicks, diskSeries), xScale, diskSeries, gochart.PlotStyle(style.Color(color.RGBA{R: 27, G: 82, B: 82, A: 200}))),
+	)
+	stacked := gochart.NewDynamicLayout(
+		gochart.NewStdYAxis(stackedScale),
+		gochart.NewStdXAxis(cpuSeries, xScale),
+		append([]gochart.Plot{gochart.NewYGrid(stackedScale)}, stackedPlots...)...,
+	)
+	stacked.SetTitle("Stacked")
+
+	// Gradient area with a line on top
+	yScale := gochart.NewYScale(gochart.AutoTicks, cpuSeries)
+	gradient := gochart.NewDynamicLayout(
+		gochart.NewStdYAxis(yScale),
+		gochart.NewStdXAxis(cpuSeries, xScale),
+		gochart.NewYGrid(yScale),
+		gochart.NewAreaPlot(yScale, xScale, cpuSeries, gochart.PlotStyle(style.VerticalGradient(
+			color.RGBA{R: 170, G: 57, B: 57, A: 255},
+			color.RGBA{R: 40, G: 14, B: 14, A: 40},
+		))),
+		gochart.NewLinesPlot(yScale, xScale, cpuSeries, gochart.PlotStyle(style.Color(color.RGBA{R: 170, G: 57, B: 57, A: 255}), style.LineWidth(2))),
+	)
+	gradient.SetTitle("Gradient")
+
+	grid := gochart.New12ColGridLayout(
+		gochart.GridRow{HeightPercent: 0.5, Columns: []gochart.GridColumn{{ColSpan: 12, El: stacked}}},
+		gochart.GridRow{HeightPercent: 0.5, Columns: []gochart.GridColumn{{ColSpan: 12, El: gradient}}},
+	)
+
+	if err := grid.Render(canvas, gochart.BoundingBoxFromCanvas(canvas)); err != nil {
+		panic(err)
+	}
+
+	if err := canvas.SavePNG("./example.png"); err != nil {
+		panic(err)
+	}
+}
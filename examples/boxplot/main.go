@@ -0,0 +1,50 @@
+package main
+
+import (
+	"image/color"
+	"math"
+	"math/rand"
+
+	"github.com/fogleman/gg"
+	"github.com/warmans/gochart"
+	"github.com/warmans/gochart/pkg/style"
+)
+
+const numSamples = 500
+
+func main() {
+
+	canvas := gg.NewContext(800, 400)
+	canvas.SetColor(color.White)
+	canvas.DrawRectangle(0, 0, float64(canvas.Width()), float64(canvas.Height()))
+	canvas.Fill()
+
+	// request latency in ms of each service.
+	services := []string{"api", "auth", "search", "billing", "web"}
+	rnd := rand.New(rand.NewSource(1))
+	samples := make([][]float64, len(services))
+	for k := range services {
+		samples[k] = make([]float64, numSamples)
+		for i := range samples[k] {
+			samples[k][i] = math.Exp(3 + float64(k)*0.2 + rnd.NormFloat64()*(0.2+float64(k%3)*0.1))
+		}
+	}
+
+	xScale := gochart.NewXScaleFromLabels(services)
+	yScale := gochart.NewYScale(gochart.AutoTicks, gochart.NewSamplesSeries(samples))
+
+	layout := gochart.NewDynamicLayout(
+		gochart.NewStdYAxis(yScale, gochart.YAxisTitle("Latency (ms)")),
+		gochart.NewCompactXAxis(services, xScale),
+		gochart.NewYGrid(yScale),
+		gochart.NewBoxPlot(yScale, xScale, samples, gochart.PlotStyle(style.Color(color.RGBA{R: 34, G: 102, B: 102, A: 255}))),
+	)
+
+	if err := layout.Render(canvas, gochart.BoundingBoxFromCanvas(canvas)); err != nil {
+		panic(err)
+	}
+
+	if err := canvas.SavePNG("./example.png"); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"image/color"
+	"math"
+	"math/rand"
+	"time"
+
+	"github.com/fogleman/gg"
+	"github.com/warmans/gochart"
+)
+
+const numDays = 45
+
+func main() {
+
+	canvas := gg.NewContext(800, 400)
+	canvas.SetColor(color.White)
+	canvas.DrawRectangle(0, 0, float64(canvas.Width()), float64(canvas.Height()))
+	canvas.Fill()
+
+	// daily prices for weekdays only so the weekends show as gaps on the time scale.
+	rand.Seed(1)
+	times := []time.Time{}
+	values := []gochart.OHLC{}
+	price := 120.0
+	for day := time.Date(2020, 3, 2, 0, 0, 0, 0, time.UTC); len(times) < numDays; day = day.AddDate(0, 0, 1) {
+		if day.Weekday() == time.Saturday || day.Weekday() == time.Sunday {
+			continue
+		}
+		open := price
+		price += rand.NormFloat64() * 3
+		times = append(times, day)
+		values = append(values, gochart.OHLC{
+			Open:  open,
+			High:  math.Max(open, price) + rand.Float64()*3,
+			Low:   math.Min(open, price) - rand.Float64()*3,
+			Close: price,
+		})
+	}
+	series := gochart.NewOHLCSeries(times, values, gochart.TimeFormat(func(t time.Time) string { return t.Format("Jan 02") }))
+
+	yScale := gochart.NewRangeYScale(gochart.AutoTicks, series)
+	xScale := gochart.NewTimeXScale(10, series)
+
+	layout := gochart.NewDynamicLayout(
+		gochart.NewStdYAxis(yScale),
+		gochart.NewStdXAxis(series, xScale),
+		gochart.NewYGrid(yScale),
+		gochart.NewCandlestickPlot(yScale, xScale, series),
+	)
+
+	if err := layout.Render(canvas, gochart.BoundingBoxFromCanvas(canvas)); err != nil {
+		panic(err)
+	}
+
+	if err := canvas.SavePNG("./example.png"); err != nil {
+		panic(err)
+	}
+}
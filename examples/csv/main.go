@@ -0,0 +1,72 @@
+package main
+
+import (
+	"image/color"
+	"os"
+
+	"github.com/fogleman/gg"
+	"github.com/warmans/gochart"
+)
+
+func main() {
+
+	canvas := gg.NewContext(800, 600)
+	canvas.SetColor(color.White)
+	canvas.DrawRectangle(0, 0, float64(canvas.Width()), float64(canvas.Height()))
+	canvas.Fill()
+
+	f, err := os.Open("./data.csv")
+	if err != nil {
+		panic(err)
+	}
+	defer f.Close()
+
+	table, err := gochart.LoadCSV(f)
+	if err != nil {
+		panic(err)
+	}
+
+	// one time series for each of the selected columns. The empty value is loaded as a missing value.
+	series, err := table.TimeSeries(
+		gochart.ColumnName("date"),
+		[]string{"2006-01-02"},
+		gochart.ColumnName("requests"),
+		gochart.ColumnName("errors"),
+	)
+	if err != nil {
+		panic(err)
+	}
+	requests, errors := series[0], series[1]
+
+	requestsYScale := gochart.NewYScale(gochart.AutoTicks, requests)
+	requestsXScale := gochart.NewTimeXScale(10, requests)
+	requestsLayout := gochart.NewDynamicLayout(
+		gochart.NewStdYAxis(requestsYScale),
+		gochart.NewStdXAxis(requests, requestsXScale),
+		gochart.NewYGrid(requestsYScale),
+		gochart.NewLinesPlot(requestsYScale, requestsXScale, requests),
+	)
+	requestsLayout.SetTitle("Requests")
+
+	errorsYScale := gochart.NewYScale(gochart.AutoTicks, errors)
+	errorsXScale := gochart.NewTimeXScale(10, errors)
+	errorsLayout := gochart.NewDynamicLayout(
+		gochart.NewStdYAxis(errorsYScale),
+		gochart.NewStdXAxis(errors, errorsXScale),
+		gochart.NewYGrid(errorsYScale),
+		gochart.NewAreaPlot(errorsYScale, errorsXScale, errors),
+	)
+	errorsLayout.SetTitle("Errors")
+
+	grid := gochart.New12ColGridLayout(
+		gochart.GridRow{HeightPercent: 0.5, Columns: []gochart.GridColumn{{ColSpan: 12, El: requestsLayout}}},
+		gochart.GridRow{HeightPercent: 0.5, Columns: []gochart.GridColumn{{ColSpan: 12, El: errorsLayout}}},
+	)
+	if err := grid.Render(canvas, gochart.BoundingBoxFromCanvas(canvas)); err != nil {
+		panic(err)
+	}
+
+	if err := canvas.SavePNG("./example.png"); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"image/color"
+	"math"
+
+	"github.com/fogleman/gg"
+	"github.com/warmans/gochart"
+	"github.com/warmans/gochart/pkg/style"
+)
+
+const numPoints = 48
+
+func main() {
+
+	canvas := gg.NewContext(800, 600)
+	canvas.SetColor(color.White)
+	canvas.DrawRectangle(0, 0, float64(canvas.Width()), float64(canvas.Height()))
+	canvas.Fill()
+
+	// hourly values with missed scrapes recorded as NaN.
+	values := make([]float64, numPoints)
+	for k := range values {
+		values[k] = 20 + 10*math.Sin(float64(k)/4)
+		if (k > 10 && k < 16) || k == 30 || k == 31 {
+			values[k] = math.NaN()
+		}
+	}
+	series := gochart.NewTimeSeries(gochart.GenTimes(numPoints), values)
+
+	chart := func(title string, mode gochart.GapMode) *gochart.DynamicLayout {
+		yScale := gochart.NewYScale(gochart.AutoTicks, series)
+		xScale := gochart.NewTimeXScale(10, series)
+		plotStyle := gochart.PlotStyle(style.Color(color.RGBA{R: 34, G: 102, B: 102, A: 255}))
+		layout := gochart.NewDynamicLayout(
+			gochart.NewStdYAxis(yScale),
+			gochart.NewStdXAxis(series, xScale),
+			gochart.NewYGrid(yScale),
+			gochart.NewLinesPlot(yScale, xScale, series, plotStyle, gochart.PlotGaps(mode)),
+			gochart.NewPointsPlot(yScale, xScale, series, plotStyle, gochart.PlotGaps(mode)),
+		)
+		layout.SetTitle(title)
+		return layout
+	}
+
+	grid := gochart.New12ColGridLayout(
+		gochart.GridRow{HeightPercent: 0.33, Columns: []gochart.GridColumn{{ColSpan: 12, El: chart("Break", gochart.GapBreak)}}},
+		gochart.GridRow{HeightPercent: 0.33, Columns: []gochart.GridColumn{{ColSpan: 12, El: chart("Connect", gochart.GapConnect)}}},
+		gochart.GridRow{HeightPercent: 0.33, Columns: []gochart.GridColumn{{ColSpan: 12, El: chart("Interpolate", gochart.GapInterpolate)}}},
+	)
+
+	if err := grid.Render(canvas, gochart.BoundingBoxFromCanvas(canvas)); err != nil {
+		panic(err)
+	}
+
+	if err := canvas.SavePNG("./example.png"); err != nil {
+		panic(err)
+	}
+}
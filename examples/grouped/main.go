@@ -0,0 +1,49 @@
+package main
+
+import (
+	"image/color"
+
+	"github.com/fogleman/gg"
+	"github.com/warmans/gochart"
+	"github.com/warmans/gochart/pkg/style"
+)
+
+func main() {
+
+	canvas := gg.NewContext(800, 400)
+	canvas.SetColor(color.White)
+	canvas.DrawRectangle(0, 0, float64(canvas.Width()), float64(canvas.Height()))
+	canvas.Fill()
+
+	quarters := []string{"Q1", "Q2", "Q3", "Q4"}
+	north := gochart.NewXYSeries(quarters, []float64{42, 51, 38, 60})
+	south := gochart.NewXYSeries(quarters, []float64{35, 44, 49, 53})
+	west := gochart.NewXYSeries(quarters, []float64{22, 31, 27, 40})
+
+	xScale := gochart.NewXScale(north, 0)
+
+	northPlot := gochart.NewBarsPlot(gochart.NewYScale(gochart.AutoTicks, north), xScale, north, gochart.PlotStyle(style.Color(color.RGBA{R: 170, G: 57, B: 57, A: 255})))
+	southPlot := gochart.NewBarsPlot(gochart.NewYScale(gochart.AutoTicks, south), xScale, south, gochart.PlotStyle(style.Color(color.RGBA{R: 45, G: 136, B: 45, A: 255})))
+	westPlot := gochart.NewBarsPlot(gochart.NewYScale(gochart.AutoTicks, west), xScale, west, gochart.PlotStyle(style.Color(color.RGBA{R: 34, G: 102, B: 102, A: 255})))
+
+	groupedPlots, groupedScale := gochart.GroupPlots(4, 32, northPlot, southPlot, westPlot)
+
+	layout := gochart.NewDynamicLayout(
+		gochart.NewStdYAxis(groupedScale),
+		gochart.NewStdXAxis(north, xScale),
+		append([]gochart.Plot{gochart.NewYGrid(groupedScale)}, groupedPlots...)...,
+	)
+	layout.SetLegend(gochart.NewLegend([]gochart.LegendEntry{
+		gochart.NewLegendEntry("north", northPlot),
+		gochart.NewLegendEntry("south", southPlot),
+		gochart.NewLegendEntry("west", westPlot),
+	}))
+
+	if err := layout.Render(canvas, gochart.BoundingBoxFromCanvas(canvas)); err != nil {
+		panic(err)
+	}
+
+	if err := canvas.SavePNG("./example.png"); err != nil {
+		panic(err)
+	}
+}
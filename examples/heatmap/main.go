@@ -0,0 +1,76 @@
+package main
+
+import (
+	"image/color"
+	"math"
+	"math/rand"
+	"time"
+
+	"github.com/fogleman/gg"
+	"github.com/warmans/gochart"
+)
+
+const numMinutes = 90
+
+func main() {
+
+	canvas := gg.NewContext(800, 400)
+	canvas.SetColor(color.White)
+	canvas.DrawRectangle(0, 0, float64(canvas.Width()), float64(canvas.Height()))
+	canvas.Fill()
+
+	// latency buckets listed from highest to lowest so the fastest requests are at the bottom.
+	buckets := []string{">1s", "<1s", "<500ms", "<250ms", "<100ms", "<50ms", "<25ms", "<10ms"}
+	upperBounds := []float64{math.Inf(1), 1000, 500, 250, 100, 50, 25, 10}
+
+	// requests per minute with latency increasing during an incident.
+	rand.Seed(1)
+	start := time.Date(2020, 1, 1, 9, 0, 0, 0, time.UTC)
+	times := make([]time.Time, numMinutes)
+	counts := make([][]float64, numMinutes)
+	for minute := range times {
+		times[minute] = start.Add(time.Minute * time.Duration(minute))
+		counts[minute] = make([]float64, len(buckets))
+
+		median := 20.0
+		if minute > 40 && minute < 60 {
+			median = 180
+		}
+		for k := 0; k < 200; k++ {
+			latency := median * math.Exp(rand.NormFloat64()*0.7)
+			for b := len(upperBounds) - 1; b >= 0; b-- {
+				if latency <= upperBounds[b] {
+					counts[minute][b]++
+					break
+				}
+			}
+		}
+	}
+	series := gochart.NewTimeMatrixSeries(times, buckets, counts)
+
+	xScale := gochart.NewTimeXScale(10, series.Columns())
+	yScale := gochart.NewCategoryYScale(buckets)
+	heatmap := gochart.NewHeatmapPlot(xScale, yScale, series)
+
+	chart := gochart.NewDynamicLayout(
+		gochart.NewCategoryYAxis(yScale),
+		gochart.NewStdXAxis(series.Columns(), xScale),
+		heatmap,
+	)
+
+	grid := gochart.New12ColGridLayout(
+		gochart.GridRow{HeightPercent: 1, Columns: []gochart.GridColumn{
+			{ColSpan: 11, El: chart},
+			{ColSpan: 1, El: heatmap.ColorBar()},
+		}},
+	)
+	grid.SetTitle("Request latency")
+
+	if err := grid.Render(canvas, gochart.BoundingBoxFromCanvas(canvas)); err != nil {
+		panic(err)
+	}
+
+	if err := canvas.SavePNG("./example.png"); err != nil {
+		panic(err)
+	}
+}
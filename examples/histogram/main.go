@@ -0,0 +1,60 @@
+package main
+
+import (
+	"image/color"
+	"math"
+	"math/rand"
+
+	"github.com/fogleman/gg"
+	"github.com/warmans/gochart"
+	"github.com/warmans/gochart/pkg/style"
+)
+
+const numSamples = 2000
+
+func main() {
+
+	canvas := gg.NewContext(800, 600)
+	canvas.SetColor(color.White)
+	canvas.DrawRectangle(0, 0, float64(canvas.Width()), float64(canvas.Height()))
+	canvas.Fill()
+
+	// request latency in ms with a long tail.
+	rnd := rand.New(rand.NewSource(1))
+	samples := make([]float64, numSamples)
+	for i := range samples {
+		samples[i] = math.Exp(3.5 + rnd.NormFloat64()*0.4)
+	}
+
+	distribution := histogramChart(gochart.NewHistogram(samples, gochart.BinFreedmanDiaconis()), "Requests")
+	distribution.SetTitle("Latency distribution (ms)")
+
+	cumulative := histogramChart(gochart.NewHistogram(samples, gochart.BinWidth(10), gochart.HistogramCumulative(), gochart.HistogramDensity()), "Fraction")
+	cumulative.SetTitle("Cumulative")
+
+	grid := gochart.New12ColGridLayout(
+		gochart.GridRow{HeightPercent: 0.5, Columns: []gochart.GridColumn{{ColSpan: 12, El: distribution}}},
+		gochart.GridRow{HeightPercent: 0.5, Columns: []gochart.GridColumn{{ColSpan: 12, El: cumulative}}},
+	)
+
+	if err := grid.Render(canvas, gochart.BoundingBoxFromCanvas(canvas)); err != nil {
+		panic(err)
+	}
+
+	if err := canvas.SavePNG("./example.png"); err != nil {
+		panic(err)
+	}
+}
+
+func histogramChart(h *gochart.Histogram, yTitle string) *gochart.DynamicLayout {
+	series := h.Series()
+	xScale := h.XScale()
+	yScale := gochart.NewYScale(gochart.AutoTicks, series)
+
+	return gochart.NewDynamicLayout(
+		gochart.NewStdYAxis(yScale, gochart.YAxisTitle(yTitle)),
+		gochart.NewStdXAxis(series, xScale),
+		gochart.NewYGrid(yScale),
+		gochart.NewBarsPlot(yScale, xScale, series, gochart.BarPadding(1), gochart.PlotStyle(style.Color(color.RGBA{R: 34, G: 102, B: 102, A: 255}))),
+	)
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"image/color"
+
+	"github.com/fogleman/gg"
+	"github.com/warmans/gochart"
+	"github.com/warmans/gochart/pkg/style"
+)
+
+func main() {
+
+	canvas := gg.NewContext(800, 600)
+	canvas.SetColor(color.White)
+	canvas.DrawRectangle(0, 0, float64(canvas.Width()), float64(canvas.Height()))
+	canvas.Fill()
+
+	endpoints := []string{"GET /api/v1/search", "POST /api/v1/orders", "GET /api/v1/products/{id}", "GET /healthz", "PUT /api/v1/basket"}
+	categoryScale := gochart.NewCategoryYScale(endpoints)
+
+	// Top endpoints
+	requests := gochart.NewXYSeries(endpoints, []float64{18250, 12400, 9800, 6100, 2300})
+	requestsScale := gochart.NewYScale(gochart.AutoTicks, requests)
+	ranking := gochart.NewDynamicLayout(
+		gochart.NewCategoryYAxis(categoryScale),
+		gochart.NewHorizontalValueAxis(requestsScale, gochart.HorizontalValueTitle("Requests")),
+		gochart.NewHorizontalGrid(requestsScale),
+		gochart.NewHorizontalBarsPlot(requestsScale, categoryScale, requests, gochart.PlotStyle(style.Color(color.RGBA{R: 34, G: 102, B: 102, A: 255}))),
+	)
+	ranking.SetTitle("Top endpoints")
+
+	// Stacked success and error rates
+	ok := gochart.NewXYSeries(endpoints, []float64{97.1, 92.4, 99.2, 100, 88.5})
+	failed := gochart.NewXYSeries(endpoints, []float64{2.9, 7.6, 0.8, 0, 11.5})
+	okPlot := gochart.NewHorizontalBarsPlot(gochart.NewYScale(gochart.AutoTicks, ok), categoryScale, ok, gochart.PlotStyle(style.Color(color.RGBA{R: 45, G: 136, B: 45, A: 255})))
+	failedPlot := gochart.NewHorizontalBarsPlot(gochart.NewYScale(gochart.AutoTicks, failed), categoryScale, failed, gochart.PlotStyle(style.Color(color.RGBA{R: 170, G: 57, B: 57, A: 255})))
+	stackedPlots, stackedScale := gochart.StackPlots(okPlot, failedPlot)
+	stacked := gochart.NewDynamicLayout(
+		gochart.NewCategoryYAxis(categoryScale),
+		gochart.NewHorizontalValueAxis(stackedScale, gochart.HorizontalValueTitle("% of requests")),
+		append([]gochart.Plot{gochart.NewHorizontalGrid(stackedScale)}, stackedPlots...)...,
+	)
+	stacked.SetTitle("Responses")
+	stacked.SetLegend(gochart.NewLegend([]gochart.LegendEntry{
+		gochart.NewLegendEntry("ok", okPlot),
+		gochart.NewLegendEntry("failed", failedPlot),
+	}))
+
+	grid := gochart.New12ColGridLayout(
+		gochart.GridRow{HeightPercent: 0.5, Columns: []gochart.GridColumn{{ColSpan: 12, El: ranking}}},
+		gochart.GridRow{HeightPercent: 0.5, Columns: []gochart.GridColumn{{ColSpan: 12, El: stacked}}},
+	)
+
+	if err := grid.Render(canvas, gochart.BoundingBoxFromCanvas(canvas)); err != nil {
+		panic(err)
+	}
+
+	if err := canvas.SavePNG("./example.png"); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"image/color"
+
+	"github.com/fogleman/gg"
+	"github.com/warmans/gochart"
+	"github.com/warmans/gochart/pkg/style"
+)
+
+func main() {
+
+	canvas := gg.NewContext(800, 400)
+	canvas.SetColor(color.White)
+	canvas.DrawRectangle(0, 0, float64(canvas.Width()), float64(canvas.Height()))
+	canvas.Fill()
+
+	days := []string{"Mon", "Tue", "Wed", "Thu", "Fri", "Sat", "Sun"}
+	api := gochart.NewXYSeries(days, []float64{12, 15, 14, 18, 21, 9, 7})
+	web := gochart.NewXYSeries(days, []float64{8, 9, 11, 10, 14, 12, 10})
+	jobs := gochart.NewXYSeries(days, []float64{4, 4, 5, 4, 6, 2, 2})
+	target := gochart.NewXYSeries(days, []float64{30, 30, 30, 30, 30, 30, 30})
+
+	xScale := gochart.NewXScale(api, 10)
+
+	apiPlot := gochart.NewBarsPlot(gochart.NewYScale(gochart.AutoTicks, api), xScale, api, gochart.PlotStyle(style.Color(color.RGBA{R: 170, G: 57, B: 57, A: 255})))
+	webPlot := gochart.NewBarsPlot(gochart.NewYScale(gochart.AutoTicks, web), xScale, web, gochart.PlotStyle(style.Color(color.RGBA{R: 45, G: 136, B: 45, A: 255})))
+	jobsPlot := gochart.NewBarsPlot(gochart.NewYScale(gochart.AutoTicks, jobs), xScale, jobs, gochart.PlotStyle(style.Color(color.RGBA{R: 34, G: 102, B: 102, A: 255})))
+
+	stackedPlots, stackedScale := gochart.StackPlots(apiPlot, webPlot, jobsPlot)
+
+	targetPlot := gochart.NewLinesPlot(stackedScale, xScale, target, gochart.PlotStyle(style.Color(color.RGBA{A: 255}), style.Dash(5)))
+
+	legend := gochart.NewLegend(
+		[]gochart.LegendEntry{
+			gochart.NewLegendEntry("api", apiPlot),
+			gochart.NewLegendEntry("web", webPlot),
+			gochart.NewLegendEntry("jobs", jobsPlot),
+			gochart.NewLegendEntry("target", targetPlot),
+		},
+		gochart.LegendAt(gochart.LegendTopLeft),
+	)
+
+	layout := gochart.NewDynamicLayout(
+		gochart.NewStdYAxis(stackedScale),
+		gochart.NewStdXAxis(api, xScale),
+		append(
+			append([]gochart.Plot{gochart.NewYGrid(stackedScale)}, stackedPlots...),
+			targetPlot,
+			legend,
+		)...,
+	)
+
+	if err := layout.Render(canvas, gochart.BoundingBoxFromCanvas(canvas)); err != nil {
+		panic(err)
+	}
+
+	if err := canvas.SavePNG("./example.png"); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"image/color"
+	"math"
+
+	"github.com/fogleman/gg"
+	"github.com/warmans/gochart"
+	"github.com/warmans/gochart/pkg/style"
+)
+
+const numPoints = 48
+
+func main() {
+
+	canvas := gg.NewContext(800, 400)
+	canvas.SetColor(color.White)
+	canvas.DrawRectangle(0, 0, float64(canvas.Width()), float64(canvas.Height()))
+	canvas.Fill()
+
+	// request latency in ms with a few large spikes.
+	latency := make([]float64, numPoints)
+	for i := range latency {
+		latency[i] = 5 + 3*math.Sin(float64(i)/3)
+		if i%11 == 7 {
+			latency[i] = 2500
+		}
+		if i%17 == 3 {
+			latency[i] = 180
+		}
+	}
+	series := gochart.NewYSeries(latency)
+
+	yScale := gochart.NewLogYScaleWithOpts([]gochart.LogYScaleOpt{gochart.LogMinorTicks()}, series)
+	xScale := gochart.NewXScale(series, 0)
+
+	layout := gochart.NewDynamicLayout(
+		gochart.NewStdYAxis(yScale),
+		gochart.NewStdXAxis(series, xScale),
+		gochart.NewYGrid(yScale),
+		gochart.NewLinesPlot(yScale, xScale, series, gochart.PlotStyle(style.Color(color.RGBA{R: 170, G: 57, B: 57, A: 255}))),
+		gochart.NewPointsPlot(yScale, xScale, series, gochart.PlotStyle(style.Color(color.RGBA{R: 170, G: 57, B: 57, A: 255}))),
+	)
+
+	if err := layout.Render(canvas, gochart.BoundingBoxFromCanvas(canvas)); err != nil {
+		panic(err)
+	}
+
+	if err := canvas.SavePNG("./example.png"); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"image/color"
+
+	"github.com/fogleman/gg"
+	"github.com/warmans/gochart"
+	"github.com/warmans/gochart/pkg/style"
+)
+
+func main() {
+
+	canvas := gg.NewContext(800, 400)
+	canvas.SetColor(color.White)
+	canvas.DrawRectangle(0, 0, float64(canvas.Width()), float64(canvas.Height()))
+	canvas.Fill()
+
+	// monthly profit/loss
+	series := gochart.NewXYSeries(
+		[]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+		[]float64{12.5, 8.2, -4.1, -9.7, 3.3, 15.8, 21.4, 6.1, -2.5, -14.2, 4.9, 18.6},
+	)
+
+	yScale := gochart.NewYScale(gochart.AutoTicks, series)
+	xScale := gochart.NewXScale(series, 0)
+
+	layout := gochart.NewDynamicLayout(
+		gochart.NewStdYAxis(yScale),
+		gochart.NewStdXAxis(series, xScale),
+		gochart.NewYGrid(yScale),
+		gochart.NewBarsPlot(yScale, xScale, series, gochart.PlotStyleFn(func(v float64) style.Opts {
+			if v < 0 {
+				return style.Opts{style.Color(color.RGBA{R: 170, G: 57, B: 57, A: 255})}
+			}
+			return style.Opts{style.Color(color.RGBA{R: 45, G: 136, B: 45, A: 255})}
+		})),
+	)
+
+	if err := layout.Render(canvas, gochart.BoundingBoxFromCanvas(canvas)); err != nil {
+		panic(err)
+	}
+
+	if err := canvas.SavePNG("./example.png"); err != nil {
+		panic(err)
+	}
+}
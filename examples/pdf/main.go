@@ -0,0 +1,78 @@
+package main
+
+import (
+	"image/color"
+	"log"
+
+	"github.com/golang/freetype/truetype"
+	"github.com/warmans/gochart"
+	"github.com/warmans/gochart/pkg/style"
+	"golang.org/x/image/font/gofont/goregular"
+)
+
+const numPoints = 22
+
+func main() {
+
+	font, err := truetype.Parse(goregular.TTF)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	face := truetype.NewFace(font, &truetype.Options{Size: 12})
+
+	// the TTF data must be registered with the renderer to embed the font in the document.
+	canvas := gochart.NewPDFRenderer(800, 400, gochart.PDFFontFace(face, goregular.TTF))
+
+	// page 1: lines and points
+	series := gochart.NewYSeries(gochart.GenSinWave(numPoints))
+
+	yScale := gochart.NewYScale(10, series)
+	xScale := gochart.NewXScale(series, 10)
+
+	layout := gochart.NewDynamicLayout(
+		gochart.NewStdYAxis(yScale, gochart.YFontStyles(style.FontFace(face))),
+		gochart.NewStdXAxis(series, xScale, gochart.XFontStyles(style.FontFace(face))),
+		gochart.NewYGrid(yScale),
+		gochart.NewLinesPlot(yScale, xScale, series, gochart.PlotStyle(style.Color(color.RGBA{R: 170, G: 57, B: 57, A: 255}))),
+		gochart.NewPointsPlot(yScale, xScale, series, gochart.PlotPointSize(4), gochart.PlotStyle(style.Color(color.RGBA{A: 255}))),
+	)
+	if err := layout.Render(canvas, gochart.BoundingBoxFromCanvas(canvas)); err != nil {
+		log.Fatal(err)
+	}
+
+	// page 2: bars with the default font
+	canvas.AddPage()
+
+	barSeries := gochart.NewXYSeries(gochart.GenTestEpisodeLabels(numPoints), gochart.GenTestData(numPoints))
+
+	barYScale := gochart.NewYScale(10, barSeries)
+	barXScale := gochart.NewXScale(barSeries, 0)
+
+	grid := gochart.New12ColGridLayout(
+		gochart.GridRow{
+			HeightPercent: 0.85,
+			Columns: []gochart.GridColumn{
+				{ColSpan: 1, El: gochart.NewStdYAxis(barYScale)},
+				{ColSpan: 11, El: gochart.NewCompositePlot(
+					gochart.NewYGrid(barYScale),
+					gochart.NewBarsPlot(barYScale, barXScale, barSeries, gochart.PlotStyle(style.Color(color.RGBA{R: 120, G: 160, B: 220, A: 255}))),
+				)},
+			},
+		},
+		gochart.GridRow{
+			HeightPercent: 0.15,
+			Columns: []gochart.GridColumn{
+				{ColSpan: 1},
+				{ColSpan: 11, El: gochart.NewCompactXAxis(barSeries.Xs(), barXScale)},
+			},
+		},
+	)
+	if err := grid.Render(canvas, gochart.BoundingBoxFromCanvas(canvas)); err != nil {
+		log.Fatal(err)
+	}
+
+	if err := canvas.SavePDF("./example.pdf"); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"fmt"
+	"image/color"
+
+	"github.com/fogleman/gg"
+	"github.com/warmans/gochart"
+	"github.com/warmans/gochart/pkg/style"
+)
+
+var colors = []color.RGBA{
+	{R: 34, G: 102, B: 102, A: 255},
+	{R: 170, G: 57, B: 57, A: 255},
+	{R: 170, G: 108, B: 57, A: 255},
+	{R: 45, G: 136, B: 45, A: 255},
+	{R: 75, G: 45, B: 115, A: 255},
+}
+
+func main() {
+
+	canvas := gg.NewContext(800, 400)
+	canvas.SetColor(color.White)
+	canvas.DrawRectangle(0, 0, float64(canvas.Width()), float64(canvas.Height()))
+	canvas.Fill()
+
+	browsers := gochart.NewXYSeries(
+		[]string{"Chrome", "Safari", "Firefox", "Edge", "Other"},
+		[]float64{64.5, 18.2, 7.1, 4.4, 5.8},
+	)
+	pieOpts := []gochart.PieOpt{gochart.PieExplode(1, 10)}
+	for k, c := range colors {
+		pieOpts = append(pieOpts, gochart.PieSliceStyles(k, style.Color(c)))
+	}
+	pie := gochart.NewPie(browsers, pieOpts...)
+
+	regions := gochart.NewXYSeries(
+		[]string{"EU", "US", "APAC", "LATAM"},
+		[]float64{420, 380, 210, 90},
+	)
+	donutOpts := []gochart.PieOpt{
+		gochart.PieStartAngle(45),
+		gochart.PiePadding(4),
+		gochart.PieLabels(gochart.PieLabelsInside),
+		gochart.PieLabelFn(func(name string, v, share float64) string {
+			return fmt.Sprintf("%0.0f%%", share*100)
+		}),
+		gochart.PieFontStyles(style.Color(color.RGBA{R: 255, G: 255, B: 255, A: 255})),
+	}
+	for k, c := range colors {
+		donutOpts = append(donutOpts, gochart.PieSliceStyles(k, style.Color(c)))
+	}
+	donut := gochart.NewDonut(regions, donutOpts...)
+
+	grid := gochart.New12ColGridLayout(
+		gochart.GridRow{HeightPercent: 1, Columns: []gochart.GridColumn{
+			{ColSpan: 7, El: pie},
+			{ColSpan: 3, El: donut},
+			{ColSpan: 2, El: gochart.NewLegend(donut.LegendEntries())},
+		}},
+	)
+
+	if err := grid.Render(canvas, gochart.BoundingBoxFromCanvas(canvas)); err != nil {
+		panic(err)
+	}
+
+	if err := canvas.SavePNG("./example.png"); err != nil {
+		panic(err)
+	}
+}
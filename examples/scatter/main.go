@@ -0,0 +1,47 @@
+package main
+
+import (
+	"image/color"
+	"math"
+
+	"github.com/fogleman/gg"
+	"github.com/warmans/gochart"
+	"github.com/warmans/gochart/pkg/style"
+)
+
+const numPoints = 64
+
+func main() {
+
+	canvas := gg.NewContext(800, 400)
+	canvas.SetColor(color.White)
+	canvas.DrawRectangle(0, 0, float64(canvas.Width()), float64(canvas.Height()))
+	canvas.Fill()
+
+	// unevenly spaced X values
+	xs := make([]float64, numPoints)
+	for i := range xs {
+		xs[i] = 500 * math.Pow(float64(i)/(numPoints-1), 1.5)
+	}
+	scatter := gochart.NewNumericSeries(xs, gochart.GenRandomTestData(numPoints, 100))
+	trend := gochart.NewNumericSeries([]float64{0, xs[numPoints-1]}, []float64{20, 80})
+
+	yScale := gochart.NewYScale(10, scatter, trend)
+	xScale := gochart.NewLinearXScale(10, scatter, trend)
+
+	layout := gochart.NewDynamicLayout(
+		gochart.NewStdYAxis(yScale),
+		gochart.NewStdXAxis(scatter, xScale),
+		gochart.NewYGrid(yScale),
+		gochart.NewPointsPlot(yScale, xScale, scatter, gochart.PlotPointSize(3), gochart.PlotStyle(style.Color(color.RGBA{B: 200, A: 255}))),
+		gochart.NewLinesPlot(yScale, xScale, trend, gochart.PlotStyle(style.Color(color.RGBA{R: 200, A: 255}), style.Dash(5))),
+	)
+
+	if err := layout.Render(canvas, gochart.BoundingBoxFromCanvas(canvas)); err != nil {
+		panic(err)
+	}
+
+	if err := canvas.SavePNG("./example.png"); err != nil {
+		panic(err)
+	}
+}
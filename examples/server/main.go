@@ -0,0 +1,19 @@
+package main
+
+import (
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/warmans/gochart"
+)
+
+// Open http://localhost:8080/chart?type=bars&data=12,15,14,18|8,9,11,10&labels=Q1,Q2,Q3,Q4&names=north,south
+func main() {
+	http.Handle("/chart", gochart.NewHandler(
+		gochart.HandlerMaxSize(1600, 1200),
+		gochart.HandlerCacheSize(256),
+		gochart.HandlerMaxAge(24*time.Hour),
+	))
+	log.Fatal(http.ListenAndServe(":8080", nil))
+}
@@ -0,0 +1,31 @@
+package main
+
+import (
+	"os"
+
+	"github.com/fogleman/gg"
+	"github.com/warmans/gochart"
+)
+
+func main() {
+
+	f, err := os.Open("./spec.yaml")
+	if err != nil {
+		panic(err)
+	}
+	defer f.Close()
+
+	spec, err := gochart.DecodeSpecYAML(f)
+	if err != nil {
+		panic(err)
+	}
+
+	canvas := gg.NewContext(spec.Size())
+	if err := spec.Render(canvas); err != nil {
+		panic(err)
+	}
+
+	if err := canvas.SavePNG("./example.png"); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"image/color"
+
+	"github.com/warmans/gochart"
+	"github.com/warmans/gochart/pkg/style"
+)
+
+const numPoints = 22
+
+func main() {
+
+	series := gochart.NewYSeries(
+		append(gochart.GenTestDataReversed(numPoints/2), gochart.GenTestData(numPoints/2)...),
+	)
+
+	series2 := gochart.NewYSeries(
+		gochart.GenTestDataFlat(numPoints, 50),
+	)
+
+	// the SVG renderer can be used anywhere a gg context would be.
+	canvas := gochart.NewSVGRenderer(800, 400)
+	canvas.SetColor(color.White)
+	canvas.DrawRectangle(0, 0, float64(canvas.Width()), float64(canvas.Height()))
+	canvas.Fill()
+
+	yScale := gochart.NewYScale(10, series)
+	xScale := gochart.NewXScale(series, 10)
+
+	layout := gochart.NewDynamicLayout(
+		gochart.NewStdYAxis(yScale),
+		gochart.NewStdXAxis(series, xScale),
+		gochart.NewYGrid(yScale),
+		gochart.NewBarsPlot(yScale, xScale, series, gochart.PlotStyle(style.Color(color.RGBA{R: 120, G: 160, B: 220, A: 255}))),
+		gochart.NewLinesPlot(yScale, xScale, series, gochart.PlotStyle(
+			style.Color(color.RGBA{A: 255}),
+			style.Dash(5),
+		)),
+		gochart.NewPointsPlot(yScale, xScale, series2, gochart.PlotPointSize(5), gochart.PlotStyle(
+			style.Color(color.RGBA{B: 255, A: 255})),
+		),
+	)
+
+	if err := layout.Render(canvas, gochart.BoundingBoxFromCanvas(canvas)); err != nil {
+		panic(err)
+	}
+
+	if err := canvas.SaveSVG("./example.svg"); err != nil {
+		panic(err)
+	}
+}
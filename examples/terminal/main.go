@@ -0,0 +1,53 @@
+package main
+
+import (
+	"image/color"
+	"os"
+
+	"github.com/warmans/gochart"
+	"github.com/warmans/gochart/pkg/style"
+)
+
+const numPoints = 32
+
+func main() {
+
+	series := gochart.NewYSeries(gochart.GenSinWave(numPoints))
+
+	yScale := gochart.NewYScale(4, series)
+	xScale := gochart.NewXScale(series, 0)
+
+	// a braille line chart with ANSI colours
+	lines := gochart.NewTerminalRenderer(80, 16, gochart.TerminalColors())
+
+	layout := gochart.NewDynamicLayout(
+		gochart.NewStdYAxis(yScale),
+		gochart.NewStdXAxis(series, xScale),
+		gochart.NewLinesPlot(yScale, xScale, series, gochart.PlotStyle(style.Color(color.RGBA{R: 220, G: 50, B: 50, A: 255}))),
+	)
+	if err := layout.Render(lines, gochart.BoundingBoxFromCanvas(lines)); err != nil {
+		panic(err)
+	}
+	if err := lines.EncodeText(os.Stdout); err != nil {
+		panic(err)
+	}
+
+	// the same bar chart definition works with block characters
+	barSeries := gochart.NewXYSeries([]string{"api", "web", "db", "cache"}, []float64{12, 30, 7, 18})
+	barYScale := gochart.NewYScale(3, barSeries)
+	barXScale := gochart.NewXScale(barSeries, 0)
+
+	bars := gochart.NewTerminalRenderer(60, 12, gochart.TerminalBlocks(), gochart.TerminalColors())
+
+	barLayout := gochart.NewDynamicLayout(
+		gochart.NewStdYAxis(barYScale),
+		gochart.NewStdXAxis(barSeries, barXScale),
+		gochart.NewBarsPlot(barYScale, barXScale, barSeries, gochart.PlotStyle(style.Color(color.RGBA{R: 50, G: 120, B: 220, A: 255}))),
+	)
+	if err := barLayout.Render(bars, gochart.BoundingBoxFromCanvas(bars)); err != nil {
+		panic(err)
+	}
+	if err := bars.EncodeText(os.Stdout); err != nil {
+		panic(err)
+	}
+}
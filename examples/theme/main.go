@@ -0,0 +1,77 @@
+package main
+
+import (
+	"github.com/fogleman/gg"
+	"github.com/warmans/gochart"
+	"github.com/warmans/gochart/pkg/style"
+)
+
+func main() {
+
+	// the dark theme with a palette that is safe for colour blind viewers.
+	theme := gochart.DarkTheme
+	theme.Palette = style.ColorBlindSafe
+
+	// layouts only fill their bounding box so the margin around it is filled with the same colour.
+	canvas := gg.NewContext(800, 600)
+	canvas.SetColor(theme.Background)
+	canvas.DrawRectangle(0, 0, float64(canvas.Width()), float64(canvas.Height()))
+	canvas.Fill()
+
+	days := []string{"Mon", "Tue", "Wed", "Thu", "Fri", "Sat", "Sun"}
+	api := gochart.NewXYSeries(days, []float64{12, 15, 14, 18, 21, 9, 7})
+	web := gochart.NewXYSeries(days, []float64{8, 9, 11, 10, 14, 12, 10})
+	jobs := gochart.NewXYSeries(days, []float64{4, 4, 5, 4, 6, 2, 2})
+
+	// Lines
+	linesXScale := gochart.NewXScale(api, 0)
+	linesYScale := gochart.NewYScale(gochart.AutoTicks, api, web, jobs)
+	apiLine := gochart.NewLinesPlot(linesYScale, linesXScale, api)
+	webLine := gochart.NewLinesPlot(linesYScale, linesXScale, web)
+	jobsLine := gochart.NewLinesPlot(linesYScale, linesXScale, jobs)
+	lines := gochart.NewDynamicLayout(
+		gochart.NewStdYAxis(linesYScale),
+		gochart.NewStdXAxis(api, linesXScale),
+		gochart.NewYGrid(linesYScale),
+		apiLine,
+		webLine,
+		jobsLine,
+	)
+	lines.SetTitle("Requests")
+	lines.SetLegend(gochart.NewLegend([]gochart.LegendEntry{
+		gochart.NewLegendEntry("api", apiLine),
+		gochart.NewLegendEntry("web", webLine),
+		gochart.NewLegendEntry("jobs", jobsLine),
+	}))
+
+	// Grouped bars
+	barsXScale := gochart.NewXScale(api, 10)
+	groupedPlots, groupedScale := gochart.GroupPlots(
+		2,
+		10,
+		gochart.NewBarsPlot(gochart.NewYScale(gochart.AutoTicks, api), barsXScale, api),
+		gochart.NewBarsPlot(gochart.NewYScale(gochart.AutoTicks, web), barsXScale, web),
+		gochart.NewBarsPlot(gochart.NewYScale(gochart.AutoTicks, jobs), barsXScale, jobs),
+	)
+	bars := gochart.NewDynamicLayout(
+		gochart.NewStdYAxis(groupedScale),
+		gochart.NewStdXAxis(api, barsXScale),
+		append([]gochart.Plot{gochart.NewYGrid(groupedScale)}, groupedPlots...)...,
+	)
+	bars.SetTitle("Errors")
+
+	grid := gochart.New12ColGridLayout(
+		gochart.GridRow{HeightPercent: 0.5, Columns: []gochart.GridColumn{{ColSpan: 12, El: lines}}},
+		gochart.GridRow{HeightPercent: 0.5, Columns: []gochart.GridColumn{{ColSpan: 12, El: bars}}},
+	)
+
+	theme.Apply(grid)
+
+	if err := grid.Render(canvas, gochart.BoundingBoxFromCanvas(canvas)); err != nil {
+		panic(err)
+	}
+
+	if err := canvas.SavePNG("./example.png"); err != nil {
+		panic(err)
+	}
+}
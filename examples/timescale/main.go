@@ -0,0 +1,51 @@
+package main
+
+import (
+	"image/color"
+	"time"
+
+	"github.com/fogleman/gg"
+	"github.com/warmans/gochart"
+	"github.com/warmans/gochart/pkg/style"
+)
+
+const numPoints = 72
+
+func main() {
+
+	canvas := gg.NewContext(800, 400)
+	canvas.SetColor(color.White)
+	canvas.DrawRectangle(0, 0, float64(canvas.Width()), float64(canvas.Height()))
+	canvas.Fill()
+
+	// hourly data with a gap where scrapes were missed.
+	times := []time.Time{}
+	values := []float64{}
+	for k, t := range gochart.GenTimes(numPoints) {
+		if k > 20 && k < 34 {
+			continue
+		}
+		times = append(times, t)
+		values = append(values, 10+float64(k%24))
+	}
+	series := gochart.NewTimeSeries(times, values)
+
+	yScale := gochart.NewYScale(10, series)
+	xScale := gochart.NewTimeXScale(10, series)
+
+	layout := gochart.NewDynamicLayout(
+		gochart.NewStdYAxis(yScale),
+		gochart.NewStdXAxis(series, xScale),
+		gochart.NewYGrid(yScale),
+		gochart.NewLinesPlot(yScale, xScale, series, gochart.PlotStyle(style.Color(color.RGBA{R: 170, G: 57, B: 57, A: 255}))),
+		gochart.NewPointsPlot(yScale, xScale, series, gochart.PlotStyle(style.Color(color.RGBA{R: 170, G: 57, B: 57, A: 255}))),
+	)
+
+	if err := layout.Render(canvas, gochart.BoundingBoxFromCanvas(canvas)); err != nil {
+		panic(err)
+	}
+
+	if err := canvas.SavePNG("./example.png"); err != nil {
+		panic(err)
+	}
+}
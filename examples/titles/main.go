@@ -0,0 +1,52 @@
+package main
+
+import (
+	"image/color"
+
+	"github.com/fogleman/gg"
+	"github.com/golang/freetype/truetype"
+	"github.com/warmans/gochart"
+	"github.com/warmans/gochart/pkg/style"
+	"golang.org/x/image/font/gofont/gobold"
+)
+
+func main() {
+
+	font, err := truetype.Parse(gobold.TTF)
+	if err != nil {
+		panic(err)
+	}
+	titleFace := truetype.NewFace(font, &truetype.Options{Size: 18})
+
+	canvas := gg.NewContext(800, 400)
+	canvas.SetColor(color.White)
+	canvas.DrawRectangle(0, 0, float64(canvas.Width()), float64(canvas.Height()))
+	canvas.Fill()
+
+	series := gochart.NewXYSeries(
+		[]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+		[]float64{3.1, 3.9, 6.4, 9.2, 12.6, 15.8, 18.1, 17.7, 14.9, 11.1, 6.8, 4.2},
+	)
+
+	yScale := gochart.NewYScale(gochart.AutoTicks, series)
+	xScale := gochart.NewXScale(series, 10)
+
+	layout := gochart.NewDynamicLayout(
+		gochart.NewStdYAxis(yScale, gochart.YAxisTitle("Temperature (C)")),
+		gochart.NewStdXAxis(series, xScale, gochart.XAxisTitle("Month")),
+		gochart.NewYGrid(yScale),
+		gochart.NewLinesPlot(yScale, xScale, series, gochart.PlotStyle(style.Color(color.RGBA{R: 170, G: 57, B: 57, A: 255}))),
+		gochart.NewPointsPlot(yScale, xScale, series, gochart.PlotPointSize(3), gochart.PlotStyle(style.Color(color.RGBA{R: 170, G: 57, B: 57, A: 255}))),
+	)
+	layout.SetTitle("Average Monthly Temperature", style.FontFace(titleFace))
+	layout.SetSubtitle("London, 1991-2020")
+	layout.SetFootnote("Source: example data")
+
+	if err := layout.Render(canvas, gochart.BoundingBoxFromCanvas(canvas)); err != nil {
+		panic(err)
+	}
+
+	if err := canvas.SavePNG("./example.png"); err != nil {
+		panic(err)
+	}
+}
@@ -7,7 +7,9 @@ require (
 	github.com/fogleman/gg v1.3.0
 	github.com/golang/freetype v0.0.0-20170609003504-e2365dfdc4a0
 	github.com/gopherjs/gopherjs v0.0.0-20200217142428-fce0ec30dd00 // indirect
+	github.com/jung-kurt/gofpdf v1.16.2
 	github.com/norunners/vue v0.0.0-20190428171114-cdefdbc96268
 	golang.org/x/image v0.0.0-20200119044424-58c23975cae1
 	golang.org/x/net v0.0.0-20200226121028-0de0cce0169b // indirect
+	gopkg.in/yaml.v2 v2.4.0
 )
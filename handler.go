@@ -0,0 +1,413 @@
+package gochart
+
+import (
+	"bytes"
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ContentType is the MIME type of the format.
+func (f SpecFormat) ContentType() string {
+	switch f {
+	case FormatSVG:
+		return "image/svg+xml"
+	case FormatPDF:
+		return "application/pdf"
+	}
+	return "image/png"
+}
+
+type HandlerOpt func(h *Handler)
+
+// HandlerMaxSize sets the largest width and height of a chart in pixels. The default is 2000x2000.
+func HandlerMaxSize(width, height int) HandlerOpt {
+	return func(h *Handler) {
+		h.maxWidth, h.maxHeight = width, height
+	}
+}
+
+// HandlerMaxBodySize sets the largest spec that can be POSTed in bytes. The default is 1MB.
+func HandlerMaxBodySize(bytes int64) HandlerOpt {
+	return func(h *Handler) {
+		h.maxBodySize = bytes
+	}
+}
+
+// HandlerCacheSize sets the number of rendered charts kept in memory. The least recently used chart is dropped when
+// the cache is full. Zero disables the cache. The default is 128.
+func HandlerCacheSize(entries int) HandlerOpt {
+	return func(h *Handler) {
+		h.cache = newRenderCache(entries)
+	}
+}
+
+// HandlerMaxAge sets how long clients may cache a chart. The default is one hour.
+func HandlerMaxAge(maxAge time.Duration) HandlerOpt {
+	return func(h *Handler) {
+		h.maxAge = maxAge
+	}
+}
+
+// HandlerTimeout sets how long a chart may take to render before the request fails with 503 Service Unavailable. The
+// default is 10 seconds.
+func HandlerTimeout(timeout time.Duration) HandlerOpt {
+	return func(h *Handler) {
+		h.timeout = timeout
+	}
+}
+
+// NewHandler creates a http.Handler that renders charts so they can be embedded in pages by URL. A GET request
+// describes a chart with query parameters:
+//
+//	type      lines, points, bars, area, pie or donut (default lines)
+//	data      the values of each series separated by | with values separated by commas e.g. 1,2,3|4,5,6
+//	labels    the X values separated by commas
+//	names     the name of each series separated by commas
+//	title     the chart title
+//	mode      overlay, stack or group
+//	x, y      the type of the X and Y scales (see XScaleSpec and YScaleSpec)
+//	theme     default or dark
+//	palette   categorical, colorblind or high_contrast
+//	w, h      the size in pixels, at least 100
+//	format    png, svg or pdf (default png)
+//	spec      a complete JSON spec used instead of the other parameters
+//
+// A POST request sends a JSON or YAML spec as the body with the format given in the query. Responses have an ETag
+// from a hash of the spec so unchanged charts are not sent again.
+func NewHandler(opts ...HandlerOpt) *Handler {
+	h := &Handler{
+		maxWidth:    2000,
+		maxHeight:   2000,
+		maxBodySize: 1 << 20,
+		maxAge:      time.Hour,
+		timeout:     10 * time.Second,
+		cache:       newRenderCache(128),
+	}
+	for _, o := range opts {
+		o(h)
+	}
+	return h
+}
+
+type Handler struct {
+	maxWidth    int
+	maxHeight   int
+	maxBodySize int64
+	maxAge      time.Duration
+	timeout     time.Duration
+	cache       *renderCache
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var spec *Spec
+	var err error
+	switch r.Method {
+	case http.MethodGet, http.MethodHead:
+		spec, err = h.querySpec(r)
+	case http.MethodPost:
+		// one byte more than the limit is read to tell if the body is too large.
+		var body []byte
+		body, err = ioutil.ReadAll(io.LimitReader(r.Body, h.maxBodySize+1))
+		if err == nil && int64(len(body)) > h.maxBodySize {
+			http.Error(w, fmt.Sprintf("specs must be at most %d bytes", h.maxBodySize), http.StatusRequestEntityTooLarge)
+			return
+		}
+		if err == nil {
+			spec, err = DecodeSpec(bytes.NewReader(body))
+		}
+	default:
+		w.Header().Set("Allow", "GET, HEAD, POST")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if err != nil {
+		h.error(w, err)
+		return
+	}
+
+	format := SpecFormat(r.URL.Query().Get("format"))
+	if format == "" {
+		format = FormatPNG
+	}
+	if format != FormatPNG && format != FormatSVG && format != FormatPDF {
+		h.error(w, SpecErrors{{Path: "format", Msg: fmt.Sprintf("unknown format %q: expected png, svg or pdf", format)}})
+		return
+	}
+
+	width, height := spec.Size()
+	if width > h.maxWidth || height > h.maxHeight {
+		http.Error(w, fmt.Sprintf("charts must be at most %dx%d", h.maxWidth, h.maxHeight), http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	layout, err := spec.Build()
+	if err != nil {
+		h.error(w, err)
+		return
+	}
+	etag, err := specETag(spec, format)
+	if err != nil {
+		h.error(w, err)
+		return
+	}
+	cacheControl := fmt.Sprintf("public, max-age=%d", int(h.maxAge.Seconds()))
+	if etagMatches(r.Header.Get("If-None-Match"), etag) {
+		w.Header().Set("ETag", etag)
+		w.Header().Set("Cache-Control", cacheControl)
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	data, ok := h.cache.get(etag)
+	if !ok {
+		if data, err = h.render(r, spec, layout, format); err != nil {
+			switch err.(type) {
+			case renderPanic:
+				http.Error(w, "failed to render chart", http.StatusInternalServerError)
+			case renderTimeout:
+				http.Error(w, "rendering the chart took too long", http.StatusServiceUnavailable)
+			default:
+				h.error(w, err)
+			}
+			return
+		}
+		h.cache.add(etag, data)
+	}
+
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Cache-Control", cacheControl)
+	w.Header().Set("Content-Type", format.ContentType())
+	w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+	if r.Method != http.MethodHead {
+		w.Write(data)
+	}
+}
+
+// renderPanic is a panic while rendering a chart so the handler can respond with an error rather than dropping the
+// connection.
+type renderPanic struct {
+	value interface{}
+}
+
+func (p renderPanic) Error() string {
+	return fmt.Sprintf("render panic: %v", p.value)
+}
+
+// renderTimeout is a chart that took longer than the handler's timeout to render.
+type renderTimeout struct{}
+
+func (renderTimeout) Error() string {
+	return "render timed out"
+}
+
+type renderResult struct {
+	data []byte
+	err  error
+}
+
+// render encodes the layout of the spec. It gives up with a renderTimeout if the timeout passes or the request is
+// cancelled first, the render cannot be interrupted so it carries on in the background and its result is dropped.
+func (h *Handler) render(r *http.Request, spec *Spec, layout *GridLayout, format SpecFormat) ([]byte, error) {
+	done := make(chan renderResult, 1)
+	go func() {
+		defer func() {
+			if p := recover(); p != nil {
+				done <- renderResult{err: renderPanic{value: p}}
+			}
+		}()
+		buf := &bytes.Buffer{}
+		err := spec.encode(buf, format, layout)
+		done <- renderResult{data: buf.Bytes(), err: err}
+	}()
+
+	timer := time.NewTimer(h.timeout)
+	defer timer.Stop()
+	select {
+	case res := <-done:
+		if res.err != nil {
+			return nil, res.err
+		}
+		return res.data, nil
+	case <-timer.C:
+		return nil, renderTimeout{}
+	case <-r.Context().Done():
+		return nil, renderTimeout{}
+	}
+}
+
+// etagMatches checks an If-None-Match header which may list several ETags, any of which may be weak.
+func etagMatches(header, etag string) bool {
+	for _, match := range strings.Split(header, ",") {
+		match = strings.TrimPrefix(strings.TrimSpace(match), "W/")
+		if match == etag || match == "*" {
+			return true
+		}
+	}
+	return false
+}
+
+// error responds with each problem of an invalid spec on its own line.
+func (h *Handler) error(w http.ResponseWriter, err error) {
+	if errs, ok := err.(SpecErrors); ok {
+		msgs := make([]string, len(errs))
+		for k, e := range errs {
+			msgs[k] = e.Error()
+		}
+		http.Error(w, strings.Join(msgs, "\n"), http.StatusBadRequest)
+		return
+	}
+	http.Error(w, err.Error(), http.StatusBadRequest)
+}
+
+// querySpec creates a spec from the parameters of a GET request.
+func (h *Handler) querySpec(r *http.Request) (*Spec, error) {
+	q := r.URL.Query()
+	if s := q.Get("spec"); s != "" {
+		return DecodeSpecJSON(strings.NewReader(s))
+	}
+
+	var errs SpecErrors
+	size := func(param string) int {
+		v := q.Get(param)
+		if v == "" {
+			return 0
+		}
+		n, err := strconv.Atoi(v)
+		if err != nil || n < minSpecSize {
+			errs = append(errs, SpecError{Path: param, Msg: fmt.Sprintf("%q is not a whole number of at least %d", v, minSpecSize)})
+		}
+		return n
+	}
+	spec := &Spec{
+		Width:   size("w"),
+		Height:  size("h"),
+		Theme:   q.Get("theme"),
+		Palette: q.Get("palette"),
+		Title:   q.Get("title"),
+	}
+
+	var labels SpecLabels
+	if l := q.Get("labels"); l != "" {
+		labels = strings.Split(l, ",")
+	}
+	var names []string
+	if n := q.Get("names"); n != "" {
+		names = strings.Split(n, ",")
+	}
+	var data []string
+	if d := q.Get("data"); d != "" {
+		data = strings.Split(d, "|")
+	} else {
+		errs = append(errs, SpecError{Path: "data", Msg: "is required"})
+	}
+	for k, values := range data {
+		s := SeriesSpec{Name: fmt.Sprintf("series %d", k+1), X: labels}
+		if k < len(names) {
+			s.Name = names[k]
+		}
+		for j, v := range strings.Split(values, ",") {
+			if v == "" || v == "null" {
+				s.Y = append(s.Y, nil)
+				continue
+			}
+			f, err := strconv.ParseFloat(v, 64)
+			if err != nil || math.IsNaN(f) || math.Abs(f) > MaxSpecValue {
+				errs = append(errs, SpecError{Path: fmt.Sprintf("data[%d][%d]", k, j), Msg: fmt.Sprintf("%q is not a finite number between %g and %g", v, -MaxSpecValue, MaxSpecValue)})
+			}
+			s.Y = append(s.Y, &f)
+		}
+		spec.Series = append(spec.Series, s)
+	}
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	chartType := q.Get("type")
+	if chartType == "" {
+		chartType = "lines"
+	}
+	chart := &ChartSpec{
+		Mode:   q.Get("mode"),
+		Legend: len(names) > 0,
+		X:      XScaleSpec{Type: q.Get("x")},
+		Y:      YScaleSpec{Type: q.Get("y")},
+	}
+	switch chartType {
+	case "pie", "donut":
+		chart.Type = chartType
+		chart.Plots = []PlotSpec{{Series: spec.Series[0].Name}}
+	default:
+		for _, s := range spec.Series {
+			chart.Plots = append(chart.Plots, PlotSpec{Type: chartType, Series: s.Name})
+		}
+	}
+	spec.Chart = chart
+	return spec, nil
+}
+
+// specETag is a hash of everything that changes the rendered chart.
+func specETag(spec *Spec, format SpecFormat) (string, error) {
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return "", fmt.Errorf("invalid spec: %w", err)
+	}
+	sum := sha256.Sum256(append(data, format...))
+	return `"` + hex.EncodeToString(sum[:16]) + `"`, nil
+}
+
+// renderCache is a least recently used cache of rendered charts.
+type renderCache struct {
+	mu      sync.Mutex
+	size    int
+	order   *list.List
+	entries map[string]*list.Element
+}
+
+type renderCacheEntry struct {
+	key  string
+	data []byte
+}
+
+func newRenderCache(size int) *renderCache {
+	return &renderCache{size: size, order: list.New(), entries: map[string]*list.Element{}}
+}
+
+func (c *renderCache) get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	el, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	c.order.MoveToFront(el)
+	return el.Value.(*renderCacheEntry).data, true
+}
+
+func (c *renderCache) add(key string, data []byte) {
+	if c.size <= 0 {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if el, ok := c.entries[key]; ok {
+		c.order.MoveToFront(el)
+		return
+	}
+	c.entries[key] = c.order.PushFront(&renderCacheEntry{key: key, data: data})
+	for c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*renderCacheEntry).key)
+	}
+}
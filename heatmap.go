@@ -0,0 +1,253 @@
+package gochart
+
+import (
+	"math"
+
+	"github.com/warmans/gochart/pkg/style"
+)
+
+// HeatmapColors sets the colour scale used for the cells of a HeatmapPlot. The default is style.Viridis.
+func HeatmapColors(colors style.ColorScale) PlotOpt {
+	return func(p Plot) {
+		if h, ok := p.(*HeatmapPlot); ok {
+			h.colors = colors
+		}
+	}
+}
+
+// HeatmapRange fixes the values mapped to either end of the colour scale of a HeatmapPlot. By default the range of
+// the series is used. A diverging scale should be given a range centered on its midpoint.
+func HeatmapRange(min, max float64) PlotOpt {
+	return func(p Plot) {
+		if h, ok := p.(*HeatmapPlot); ok {
+			h.min, h.max = min, max
+		}
+	}
+}
+
+// NewHeatmapPlot creates a plot that draws each value of the series as a cell coloured by its value. Columns are
+// placed by the X scale, which should be created from the series' Columns. Rows are drawn top to bottom in the
+// bands of the category scale so list buckets from highest to lowest to have the smallest at the bottom. Missing
+// (NaN) cells are not drawn.
+func NewHeatmapPlot(xScale XScale, yScale *CategoryYScale, s *MatrixSeries, opts ...PlotOpt) *HeatmapPlot {
+	min, max := s.Range()
+	p := &HeatmapPlot{
+		Styles: NewStyles(),
+		xScale: xScale,
+		yScale: yScale,
+		s:      s,
+		colors: style.Viridis,
+		min:    min,
+		max:    max,
+	}
+	for _, o := range opts {
+		o(p)
+	}
+	return p
+}
+
+type HeatmapPlot struct {
+	Styles
+	xScale XScale
+	yScale *CategoryYScale
+	s      *MatrixSeries
+	colors style.ColorScale
+	min    float64
+	max    float64
+}
+
+// ValueRange returns the values mapped to either end of the colour scale.
+func (c *HeatmapPlot) ValueRange() (float64, float64) {
+	return c.min, c.max
+}
+
+// ColorBar creates a colour bar matching the colours and range of the plot.
+func (c *HeatmapPlot) ColorBar(opts ...ColorBarOpt) *ColorBar {
+	return NewColorBar(c.colors, c.min, c.max, opts...)
+}
+
+func (c *HeatmapPlot) Render(canvas Renderer, b BoundingBox) error {
+
+	canvas.Push()
+	defer canvas.Pop()
+
+	c.styleOpts.Apply(canvas)
+
+	columns := c.s.Columns()
+	edges := c.columnEdges(columns, b)
+	bandHeight := c.yScale.BandHeight(b)
+
+	for col := 0; col < len(edges)-1; col++ {
+		// cells are aligned to whole pixels so neighbouring cells do not leave seams between them.
+		left, right := math.Round(edges[col]), math.Round(edges[col+1])
+		for row := range c.s.Rows() {
+			v := c.s.Value(col, row)
+			if math.IsNaN(v) {
+				continue
+			}
+			top := math.Round(c.yScale.Position(row, b))
+			bottom := math.Round(c.yScale.Position(row, b) + bandHeight)
+
+			canvas.SetColor(c.colors(normalizeToRange(v, c.min, math.Max(c.max, c.min+1e-9), 0, 1)))
+			canvas.DrawRectangle(left, top, right-left, bottom-top)
+			canvas.Fill()
+		}
+	}
+
+	return nil
+}
+
+// columnEdges returns the left edge of each column followed by the right edge of the last one. On continuous scales
+// each cell is centered on its X value and extends half way to its neighbours.
+func (c *HeatmapPlot) columnEdges(columns Series, b BoundingBox) []float64 {
+	numColumns := len(columns.Ys())
+	edges := make([]float64, numColumns+1)
+	if numColumns == 0 {
+		return edges[:0]
+	}
+
+	cs, ok := c.xScale.(ContinuousXScale)
+	if !ok {
+		for i := 0; i <= numColumns; i++ {
+			edges[i] = c.xScale.Position(i, b)
+		}
+		return edges
+	}
+
+	centers := make([]float64, numColumns)
+	for i := range centers {
+		centers[i] = cs.PositionValue(seriesXValue(columns, i), b)
+	}
+	if numColumns == 1 {
+		return []float64{b.RelX(0), b.RelX(b.W)}
+	}
+	for i := 1; i < numColumns; i++ {
+		edges[i] = (centers[i-1] + centers[i]) / 2
+	}
+	edges[0] = centers[0] - (edges[1] - centers[0])
+	edges[numColumns] = centers[numColumns-1] + (centers[numColumns-1] - edges[numColumns-1])
+	for i := range edges {
+		edges[i] = math.Min(math.Max(edges[i], b.RelX(0)), b.RelX(b.W))
+	}
+	return edges
+}
+
+// ReplaceSeries does nothing as the plot is drawn from a matrix rather than a series.
+func (c *HeatmapPlot) ReplaceSeries(fn func(s Series) Series) {
+}
+
+// ReplaceYScale does nothing as the rows are placed by a category scale.
+func (c *HeatmapPlot) ReplaceYScale(fn func(s YScale) YScale) {
+}
+
+// YScale returns nil as the rows are placed by a category scale rather than a value scale.
+func (c *HeatmapPlot) YScale() YScale {
+	return nil
+}
+
+// the width of the strip of colour drawn by a ColorBar.
+const colorBarWidth = 12
+
+type ColorBarOpt func(c *ColorBar)
+
+func ColorBarFontStyles(opt ...style.Opt) ColorBarOpt {
+	return func(c *ColorBar) {
+		c.fontStyles.SetStyle(opt...)
+	}
+}
+
+func ColorBarLineStyles(opt ...style.Opt) ColorBarOpt {
+	return func(c *ColorBar) {
+		c.lineStyles.SetStyle(opt...)
+	}
+}
+
+// NewColorBar creates a vertical strip showing the colours of the scale from min (bottom) to max (top) with labels
+// at round values. It can be placed next to a HeatmapPlot e.g. in a GridLayout column.
+func NewColorBar(colors style.ColorScale, min, max float64, opts ...ColorBarOpt) *ColorBar {
+	c := &ColorBar{
+		colors:     colors,
+		min:        min,
+		max:        max,
+		fontStyles: newThemeStyles(DefaultTheme.FontStyles),
+		lineStyles: newThemeStyles(DefaultTheme.FontStyles),
+	}
+	c.lineStyles.SetStyle(style.LineWidth(1))
+	for _, o := range opts {
+		o(c)
+	}
+	return c
+}
+
+type ColorBar struct {
+	colors     style.ColorScale
+	min        float64
+	max        float64
+	fontStyles Styles
+	lineStyles Styles
+}
+
+// Width returns the space needed to draw the bar and its labels.
+func (c *ColorBar) Width(canvas Renderer, height float64) float64 {
+	canvas.Push()
+	defer canvas.Pop()
+	c.fontStyles.styleOpts.Apply(canvas)
+
+	labelWidth, _ := widestLabelSize(canvas, c.labels(height))
+	return defaultMargin + colorBarWidth + defaultTickSize + defaultMargin/2 + labelWidth + defaultMargin
+}
+
+func (c *ColorBar) Render(canvas Renderer, b BoundingBox) error {
+
+	canvas.Push()
+	defer canvas.Pop()
+
+	bar := BoundingBox{
+		X: math.Round(b.RelX(defaultMargin)),
+		Y: math.Round(b.RelY(defaultMargin)),
+		W: colorBarWidth,
+		H: math.Round(b.H - defaultMargin*2),
+	}
+	if bar.H <= 0 {
+		return nil
+	}
+
+	// the scale is drawn as a band of colour per pixel from the top (max) to the bottom (min).
+	for y := 0.0; y < bar.H; y++ {
+		canvas.SetColor(c.colors(1 - (y+0.5)/bar.H))
+		canvas.DrawRectangle(bar.RelX(0), bar.RelY(y), bar.W, 1)
+		canvas.Fill()
+	}
+
+	c.lineStyles.styleOpts.Apply(canvas)
+	canvas.DrawRectangle(bar.RelX(0), bar.RelY(0), bar.W, bar.H)
+	canvas.Stroke()
+
+	for _, label := range c.labels(bar.H) {
+		y := bar.MapY(c.min, c.max, label.At)
+		canvas.DrawLine(bar.RelX(bar.W), y, bar.RelX(bar.W)+defaultTickSize, y)
+		canvas.Stroke()
+
+		canvas.Push()
+		c.fontStyles.styleOpts.Apply(canvas)
+		canvas.DrawStringAnchored(label.Value, bar.RelX(bar.W)+defaultTickSize+defaultMargin/2, y, 0, 0.35)
+		canvas.Pop()
+	}
+
+	return nil
+}
+
+// labels returns round values within the range of the bar. The range is not extended to the round values as it
+// would no longer match the colours of the plot.
+func (c *ColorBar) labels(height float64) []Label {
+	labels := []Label{}
+	// extreme or infinite values are limited to a range that can be divided into ticks.
+	min, max := tickableRange(c.min, c.max)
+	tolerance := (max - min) * 1e-9
+	for _, l := range yLabels(min, max, AutoTicks, height) {
+		if l.At >= min-tolerance && l.At <= max+tolerance {
+			labels = append(labels, l)
+		}
+	}
+	return labels
+}
@@ -0,0 +1,27 @@
+package gochart
+
+import (
+	"math"
+	"testing"
+
+	"github.com/warmans/gochart/pkg/style"
+)
+
+func TestColorBarExtremeValues(t *testing.T) {
+	ranges := [][2]float64{
+		{-1e308, 1e308},
+		{1e308, 1e308},
+		{math.Inf(-1), math.Inf(1)},
+		{0, 0},
+	}
+	for _, r := range ranges {
+		bar := NewColorBar(style.Viridis, r[0], r[1])
+		labels := bar.labels(200)
+		if len(labels) > 11 {
+			t.Fatalf("expected at most 11 labels for %v, got %d", r, len(labels))
+		}
+		if err := bar.Render(NewSVGRenderer(100, 200), BoundingBox{W: 100, H: 200}); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,221 @@
+package gochart
+
+import (
+	"math"
+	"sort"
+)
+
+// maxBins is the most bins a rule creates so a wide range of samples (e.g. a single extreme outlier) cannot use
+// all the memory.
+const maxBins = 1000
+
+// BinRule chooses the edges of the bins of a histogram from the sorted samples.
+type BinRule func(sorted []float64) []float64
+
+// BinCount divides the range of the samples into n bins of equal width. There are at most 1000 bins.
+func BinCount(n int) BinRule {
+	return func(sorted []float64) []float64 {
+		return equalBins(sorted[0], sorted[len(sorted)-1], n)
+	}
+}
+
+// BinWidth uses bins of the given width aligned to multiples of the width. If that needs more than 1000 bins the
+// range is divided into 1000 bins instead. Sturges' rule is used if the width is not a positive number.
+func BinWidth(width float64) BinRule {
+	return func(sorted []float64) []float64 {
+		if !(width > 0) || math.IsInf(width, 0) {
+			return BinSturges()(sorted)
+		}
+		start := math.Floor(sorted[0]/width) * width
+		n := math.Max(math.Ceil((sorted[len(sorted)-1]-start)/width), 1)
+		if !(n <= maxBins) {
+			return equalBins(sorted[0], sorted[len(sorted)-1], maxBins)
+		}
+		return equalBins(start, start+width*n, int(n))
+	}
+}
+
+// BinSturges chooses the number of bins using Sturges' rule (log2(n) + 1). It suits roughly normal distributions of
+// small samples.
+func BinSturges() BinRule {
+	return func(sorted []float64) []float64 {
+		return BinCount(int(math.Ceil(math.Log2(float64(len(sorted))))) + 1)(sorted)
+	}
+}
+
+// BinFreedmanDiaconis chooses the bin width from the interquartile range of the samples making it robust against
+// outliers and long tails e.g. request latency. It falls back to Sturges' rule if the IQR is zero.
+func BinFreedmanDiaconis() BinRule {
+	return func(sorted []float64) []float64 {
+		iqr := quantile(sorted, 0.75) - quantile(sorted, 0.25)
+		if iqr <= 0 {
+			return BinSturges()(sorted)
+		}
+		width := 2 * iqr / math.Cbrt(float64(len(sorted)))
+		n := math.Ceil((sorted[len(sorted)-1] - sorted[0]) / width)
+		return BinCount(int(math.Min(n, maxBins)))(sorted)
+	}
+}
+
+func equalBins(min, max float64, n int) []float64 {
+	if n < 1 {
+		n = 1
+	}
+	if n > maxBins {
+		n = maxBins
+	}
+	if max <= min {
+		max = min + 1
+	}
+	edges := make([]float64, n+1)
+	for i := range edges {
+		edges[i] = min + (max-min)*float64(i)/float64(n)
+	}
+	return edges
+}
+
+type HistogramOpt func(h *Histogram)
+
+// HistogramCumulative makes each bin include the samples of all the bins before it.
+func HistogramCumulative() HistogramOpt {
+	return func(h *Histogram) {
+		h.cumulative = true
+	}
+}
+
+// HistogramDensity normalises the bins so their total area is 1 (or, combined with HistogramCumulative, so the last
+// bin is 1).
+func HistogramDensity() HistogramOpt {
+	return func(h *Histogram) {
+		h.density = true
+	}
+}
+
+// NewHistogram counts the samples in bins chosen by the rule. The histogram provides the series and X scale to draw
+// it with a BarsPlot e.g.
+//
+//	NewBarsPlot(NewYScale(AutoTicks, h.Series()), h.XScale(), h.Series(), BarPadding(1))
+func NewHistogram(samples []float64, rule BinRule, opts ...HistogramOpt) *Histogram {
+	h := &Histogram{}
+	for _, o := range opts {
+		o(h)
+	}
+
+	sorted := make([]float64, 0, len(samples))
+	for _, v := range samples {
+		if !math.IsNaN(v) && !math.IsInf(v, 0) {
+			sorted = append(sorted, v)
+		}
+	}
+	sort.Float64s(sorted)
+
+	if len(sorted) == 0 {
+		h.edges = equalBins(0, 1, 1)
+	} else {
+		if rule != nil {
+			h.edges = rule(sorted)
+		}
+		// a bin needs two edges so a rule that returns fewer is replaced with Sturges' rule.
+		if len(h.edges) < 2 {
+			h.edges = BinSturges()(sorted)
+		}
+	}
+
+	h.counts = make([]float64, len(h.edges)-1)
+	for _, v := range sorted {
+		// bins include their lower edge, the last bin also includes its upper edge.
+		i := sort.SearchFloat64s(h.edges, v)
+		if i == len(h.edges) || h.edges[i] != v {
+			i--
+		}
+		if i >= len(h.counts) {
+			i = len(h.counts) - 1
+		}
+		if i >= 0 {
+			h.counts[i]++
+		}
+	}
+
+	total := 0.0
+	for i := range h.counts {
+		if h.density && !h.cumulative && len(sorted) > 0 {
+			h.counts[i] /= float64(len(sorted)) * (h.edges[i+1] - h.edges[i])
+		}
+		if h.cumulative {
+			total += h.counts[i]
+			h.counts[i] = total
+			if h.density && len(sorted) > 0 {
+				h.counts[i] /= float64(len(sorted))
+			}
+		}
+	}
+	return h
+}
+
+type Histogram struct {
+	edges      []float64
+	counts     []float64
+	cumulative bool
+	density    bool
+}
+
+// Edges returns the edges of the bins. There is one more edge than there are bins.
+func (h *Histogram) Edges() []float64 {
+	return h.edges
+}
+
+// Series returns a series with the value of each bin. The X value of each bin is its centre so points and lines
+// drawn on the histogram's X scale line up with the middle of the bars.
+func (h *Histogram) Series() Series {
+	centres := make([]float64, len(h.counts))
+	for i := range h.counts {
+		centres[i] = (h.edges[i] + h.edges[i+1]) / 2
+	}
+	return NewNumericSeries(centres, h.counts)
+}
+
+// XScale returns a scale with one tick per bin labelled with the bin edges.
+func (h *Histogram) XScale() *BinXScale {
+	return &BinXScale{edges: h.edges}
+}
+
+// BinXScale is a continuous scale spanning the edges of a set of equal width bins. Bar plots draw one bar per bin.
+type BinXScale struct {
+	edges []float64
+}
+
+func (s *BinXScale) NumTicks() int {
+	return len(s.edges) - 1
+}
+
+func (s *BinXScale) Labels() []Label {
+	labels := make([]Label, len(s.edges))
+	for k, v := range s.edges {
+		labels[k] = Label{Value: formatFloat(v), Tick: k, At: v}
+	}
+	return labels
+}
+
+// Position returns the lower edge of the i-th bin.
+func (s *BinXScale) Position(i int, b BoundingBox) float64 {
+	if i > s.NumTicks() {
+		i = s.NumTicks()
+	}
+	if i < 0 {
+		i = 0
+	}
+	return s.PositionValue(s.edges[i], b)
+}
+
+func (s *BinXScale) PositionValue(v float64, b BoundingBox) float64 {
+	min, max := s.MinMax()
+	return b.MapX(min, max, v)
+}
+
+func (s *BinXScale) Offset() float64 {
+	return 0
+}
+
+func (s *BinXScale) MinMax() (float64, float64) {
+	return s.edges[0], s.edges[len(s.edges)-1]
+}
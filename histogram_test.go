@@ -0,0 +1,50 @@
+package gochart
+
+import (
+	"math"
+	"testing"
+)
+
+func TestHistogramInvalidRules(t *testing.T) {
+	samples := []float64{1, 2, 2, 3, 3, 3, 4, 4, 5}
+	rules := map[string]BinRule{
+		"zero width":     BinWidth(0),
+		"negative width": BinWidth(-1),
+		"NaN width":      BinWidth(math.NaN()),
+		"no edges":       func(sorted []float64) []float64 { return nil },
+		"one edge":       func(sorted []float64) []float64 { return []float64{1} },
+		"nil rule":       nil,
+	}
+	for name, rule := range rules {
+		t.Run(name, func(t *testing.T) {
+			h := NewHistogram(samples, rule)
+			edges := h.Edges()
+			if len(edges) < 2 {
+				t.Fatalf("expected at least 2 edges, got %v", edges)
+			}
+			for _, e := range edges {
+				if math.IsNaN(e) || math.IsInf(e, 0) {
+					t.Fatalf("expected finite edges, got %v", edges)
+				}
+			}
+			total := 0.0
+			for _, v := range h.Series().Ys() {
+				total += v
+			}
+			if total != float64(len(samples)) {
+				t.Fatalf("expected %d samples in the bins, got %v", len(samples), total)
+			}
+		})
+	}
+}
+
+func TestBinXScalePositionOutOfRange(t *testing.T) {
+	scale := NewHistogram([]float64{1, 2, 3}, BinCount(2)).XScale()
+	b := BoundingBox{W: 100, H: 100}
+	if got := scale.Position(-1, b); got != scale.Position(0, b) {
+		t.Fatalf("expected a negative tick at the first edge, got %v", got)
+	}
+	if got := scale.Position(10, b); got != scale.Position(scale.NumTicks(), b) {
+		t.Fatalf("expected a tick past the end at the last edge, got %v", got)
+	}
+}
@@ -3,9 +3,6 @@ package gochart
 import (
 	"fmt"
 	"image/color"
-	"math"
-
-	"github.com/fogleman/gg"
 )
 
 type BoundingBox struct {
@@ -18,17 +15,17 @@ type BoundingBox struct {
 // MapX takes the given min/max and maps them to the box then returns the value X position within that scale.
 // E.g. val:2 min:1 max: 3 of a 100x100 box will return 50
 func (b BoundingBox) MapX(min, max, value float64) float64 {
-	return normalizeToRange(value, min, max, b.RelX(0), b.W+b.X) //todo: is +X needed?
+	return normalizeToRange(value, min, max, b.RelX(0), b.RelX(b.W))
 }
 
 // MapY takes the given min/max and maps them to the box then returns the value Y position within that scale.
 // E.g. val:2 min:1 max: 3 of a 100x100 box will return 50
 func (b BoundingBox) MapY(min, max, value float64) float64 {
-	//todo: min value must be zero'd otherwise it pushes the scale off the chart.
-	// this is a bug probably in normalizeToRange :/
-
-	// using a valMax of 0 will create an infinite loop somewhere.
-	return b.RelY(b.H) - normalizeToRange(value, 0, math.Max(max, 1), 0, b.H)
+	// an empty range would divide by zero.
+	if max <= min {
+		max = min + 1
+	}
+	return b.RelY(b.H) - normalizeToRange(value, min, max, 0, b.H)
 }
 
 // RelX is the relative position within the canvas i.e. 0 is the far left of the box, not the far left
@@ -43,7 +40,7 @@ func (b BoundingBox) RelY(pos float64) float64 {
 	return b.Y + pos
 }
 
-func (b BoundingBox) DebugRender(canvas *gg.Context) {
+func (b BoundingBox) DebugRender(canvas Renderer) {
 	canvas.Push()
 	defer canvas.Pop()
 	canvas.SetColor(color.RGBA{R: 0, G: 0, B: 0, A: 128})
@@ -53,29 +50,54 @@ func (b BoundingBox) DebugRender(canvas *gg.Context) {
 }
 
 func NewDynamicLayout(yAxis YAxis, xAxis XAxis, charts ...Plot) *DynamicLayout {
-	return &DynamicLayout{charts: charts, yAxis: yAxis, xAxis: xAxis}
+	l := &DynamicLayout{
+		Titles:     newTitles(),
+		charts:     charts,
+		yAxis:      yAxis,
+		xAxis:      xAxis,
+		background: DefaultTheme.Background,
+	}
+	DefaultTheme.colorPlots(charts)
+	return l
 }
 
 // DynamicLayout will calculate size of axis based on the given data.
 type DynamicLayout struct {
+	Titles
 	charts []Plot
 	yAxis  YAxis
 	xAxis  XAxis
+	legend *Legend
+	// background is the theme's background colour.
+	background color.RGBA
+}
+
+// SetLegend reserves space to the right of the plot area for the legend. To draw a legend inside the plot area
+// pass it to NewDynamicLayout along with the plots instead.
+func (l *DynamicLayout) SetLegend(legend *Legend) {
+	l.legend = legend
 }
 
-func (l *DynamicLayout) Render(canvas *gg.Context, container BoundingBox) error {
+func (l *DynamicLayout) Render(canvas Renderer, container BoundingBox) error {
 
 	//container.DebugRender(canvas)
 
-	maxYLabelW, _ := widestLabelSize(canvas, l.yAxis.Scale().Labels())
+	drawBackground(canvas, l.background, container)
+	container = l.renderTitles(canvas, container)
 
-	yAxisWidth := maxYLabelW + defaultMargin
 	xAxisHeight := l.xAxis.Height(canvas)
+	yAxisWidth := yAxisWidth(canvas, l.yAxis, container.H-xAxisHeight)
+
+	legendWidth := 0.0
+	if l.legend != nil {
+		legendWidth, _ = l.legend.Size(canvas, container.H-xAxisHeight-defaultMargin*2)
+		legendWidth += defaultMargin
+	}
 
 	chartPosition := BoundingBox{
 		X: container.RelX(0) + yAxisWidth,
 		Y: container.RelY(0),
-		W: container.W - yAxisWidth,
+		W: container.W - yAxisWidth - legendWidth,
 		H: container.H - xAxisHeight,
 	}
 
@@ -102,20 +124,46 @@ func (l *DynamicLayout) Render(canvas *gg.Context, container BoundingBox) error
 	bottomAxisPosition := BoundingBox{
 		X: container.RelX(0) + yAxisWidth,
 		Y: container.RelY(container.H) - xAxisHeight,
-		W: container.W - yAxisWidth,
+		W: container.W - yAxisWidth - legendWidth,
 		H: xAxisHeight,
 	}
 	if err := l.xAxis.Render(canvas, bottomAxisPosition); err != nil {
 		return err
 	}
 
+	if l.legend != nil {
+		legendPosition := BoundingBox{
+			X: container.RelX(container.W) - legendWidth,
+			Y: container.RelY(0),
+			W: legendWidth,
+			H: container.H - xAxisHeight,
+		}
+		if err := l.legend.Render(canvas, legendPosition); err != nil {
+			return err
+		}
+	}
+
 	//bottomAxisPosition.DebugRender(canvas)
 
 	return nil
 }
 
 func New12ColGridLayout(rows ...GridRow) *GridLayout {
-	return &GridLayout{rows: rows, numColumns: 12}
+	DefaultTheme.colorPlots(gridPlots(rows))
+	return &GridLayout{Titles: newTitles(), rows: rows, numColumns: 12, background: DefaultTheme.Background}
+}
+
+// gridPlots are the plots placed directly in the columns of the rows (rather than in a layout).
+func gridPlots(rows []GridRow) []Plot {
+	var plots []Plot
+	for _, row := range rows {
+		for _, col := range row.Columns {
+			if p, ok := col.El.(Plot); ok {
+				plots = append(plots, p)
+			}
+		}
+	}
+	return plots
 }
 
 type GridRow struct {
@@ -129,11 +177,16 @@ type GridColumn struct {
 }
 
 type GridLayout struct {
+	Titles
 	numColumns int64
 	rows       []GridRow
+	background color.RGBA
 }
 
-func (l *GridLayout) Render(canvas *gg.Context, container BoundingBox) error {
+func (l *GridLayout) Render(canvas Renderer, container BoundingBox) error {
+
+	drawBackground(canvas, l.background, container)
+	container = l.renderTitles(canvas, container)
 
 	var heightOffset float64
 	for _, row := range l.rows {
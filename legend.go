@@ -0,0 +1,206 @@
+package gochart
+
+import (
+	"math"
+
+	"github.com/warmans/gochart/pkg/style"
+)
+
+// Swatch is the symbol drawn next to a legend entry's name.
+type Swatch int
+
+const (
+	SwatchBar Swatch = iota
+	SwatchLine
+	SwatchPoint
+)
+
+type LegendEntry struct {
+	Name   string
+	Swatch Swatch
+	Styles style.Opts
+}
+
+// NewLegendEntry creates an entry with the same style as the given plot. The swatch is chosen based on the type of
+// plot. The style is read from the plot when the legend is drawn so the entry matches the colour the plot is given by
+// its layout or theme.
+func NewLegendEntry(name string, p Plot) LegendEntry {
+	entry := LegendEntry{Name: name, Swatch: SwatchBar}
+	switch p.(type) {
+	case *LinesPlot:
+		entry.Swatch = SwatchLine
+	case *PointsPlot:
+		entry.Swatch = SwatchPoint
+	}
+	if s, ok := p.(interface{ styles() style.Opts }); ok {
+		entry.Styles = style.Opts{func(canvas style.Canvas) {
+			s.styles().Apply(canvas)
+		}}
+	}
+	return entry
+}
+
+// LegendPosition is the corner of the bounding box the legend is drawn in.
+type LegendPosition int
+
+const (
+	LegendTopLeft LegendPosition = iota
+	LegendTopRight
+	LegendBottomLeft
+	LegendBottomRight
+)
+
+type LegendOpt func(l *Legend)
+
+func LegendAt(pos LegendPosition) LegendOpt {
+	return func(l *Legend) {
+		l.position = pos
+	}
+}
+
+func LegendFontStyles(opt ...style.Opt) LegendOpt {
+	return func(l *Legend) {
+		l.fontStyles.SetStyle(opt...)
+	}
+}
+
+func LegendBackgroundStyles(opt ...style.Opt) LegendOpt {
+	return func(l *Legend) {
+		l.bgStyles.SetStyle(opt...)
+	}
+}
+
+// NewLegend creates a legend listing the given entries. It can be drawn inside the plot area by passing it to a
+// layout along with the plots or in its own space e.g. a GridLayout column or DynamicLayout.SetLegend. Entries
+// wrap into further columns when they do not fit into the height available.
+func NewLegend(entries []LegendEntry, opts ...LegendOpt) *Legend {
+	l := &Legend{
+		entries:    entries,
+		fontStyles: newThemeStyles(DefaultTheme.FontStyles),
+		bgStyles:   newThemeStyles(DefaultTheme.LegendBackgroundStyles),
+	}
+	for _, o := range opts {
+		o(l)
+	}
+	return l
+}
+
+type Legend struct {
+	entries    []LegendEntry
+	position   LegendPosition
+	fontStyles Styles
+	bgStyles   Styles
+}
+
+// Size returns the space needed to draw the legend within the given height.
+func (l *Legend) Size(canvas Renderer, maxHeight float64) (float64, float64) {
+	canvas.Push()
+	defer canvas.Pop()
+	l.fontStyles.styleOpts.Apply(canvas)
+
+	rows, cols, colWidth := l.grid(canvas, maxHeight)
+	return float64(cols)*colWidth + defaultMargin, float64(rows)*l.rowHeight(canvas) + defaultMargin
+}
+
+func (l *Legend) Render(canvas Renderer, b BoundingBox) error {
+	if len(l.entries) == 0 {
+		return nil
+	}
+
+	canvas.Push()
+	defer canvas.Pop()
+
+	w, h := l.Size(canvas, b.H-defaultMargin*2)
+
+	// position the legend in the requested corner leaving a margin from the edges.
+	x := b.RelX(defaultMargin)
+	if l.position == LegendTopRight || l.position == LegendBottomRight {
+		x = b.RelX(b.W-defaultMargin) - w
+	}
+	y := b.RelY(defaultMargin)
+	if l.position == LegendBottomLeft || l.position == LegendBottomRight {
+		y = b.RelY(b.H-defaultMargin) - h
+	}
+
+	canvas.Push()
+	l.bgStyles.styleOpts.Apply(canvas)
+	canvas.DrawRectangle(x, y, w, h)
+	canvas.Fill()
+	canvas.Pop()
+
+	l.fontStyles.styleOpts.Apply(canvas)
+
+	rows, _, colWidth := l.grid(canvas, b.H-defaultMargin*2)
+	rowHeight := l.rowHeight(canvas)
+	swatchSize := canvas.FontHeight()
+
+	for k, entry := range l.entries {
+		entryX := x + defaultMargin + float64(k/rows)*colWidth
+		entryY := y + defaultMargin/2 + float64(k%rows)*rowHeight + rowHeight/2
+
+		canvas.Push()
+		entry.Styles.Apply(canvas)
+		l.drawSwatch(canvas, entry.Swatch, entryX, entryY, swatchSize)
+		canvas.Pop()
+
+		canvas.DrawStringAnchored(entry.Name, entryX+swatchSize+defaultMargin/2, entryY, 0, 0.35)
+	}
+
+	return nil
+}
+
+func (l *Legend) ReplaceSeries(fn func(s Series) Series) {
+	// no op - legend doesn't need a series
+}
+
+func (l *Legend) ReplaceYScale(fn func(s YScale) YScale) {
+	// no op - legend doesn't need a scale
+}
+
+func (l *Legend) YScale() YScale {
+	return nil
+}
+
+func (l *Legend) SetStyle(opt ...style.Opt) {
+	l.fontStyles.SetStyle(opt...)
+}
+
+func (l *Legend) drawSwatch(canvas Renderer, swatch Swatch, x, y, size float64) {
+	switch swatch {
+	case SwatchLine:
+		canvas.DrawLine(x, y, x+size, y)
+		canvas.Stroke()
+	case SwatchPoint:
+		canvas.DrawCircle(x+size/2, y, size/4)
+		canvas.Fill()
+	default:
+		canvas.DrawRectangle(x, y-size/2, size, size)
+		canvas.Fill()
+	}
+}
+
+func (l *Legend) rowHeight(canvas Renderer) float64 {
+	return canvas.FontHeight() + defaultMargin/2
+}
+
+// grid finds the number of rows and columns needed to fit the entries into the height along with the width of
+// each column.
+func (l *Legend) grid(canvas Renderer, maxHeight float64) (int, int, float64) {
+	rows := int(math.Max(math.Floor((maxHeight-defaultMargin)/l.rowHeight(canvas)), 1))
+	if rows > len(l.entries) {
+		rows = len(l.entries)
+	}
+	if rows < 1 {
+		return 0, 0, 0
+	}
+	cols := int(math.Ceil(float64(len(l.entries)) / float64(rows)))
+
+	colWidth := 0.0
+	for _, entry := range l.entries {
+		w, _ := canvas.MeasureString(entry.Name)
+		colWidth = math.Max(colWidth, w)
+	}
+	colWidth += canvas.FontHeight() + defaultMargin*1.5
+
+	return rows, cols, colWidth
+}
@@ -0,0 +1,498 @@
+package gochart
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// LayoutUnix can be given as a time layout to parse times as seconds since the Unix epoch.
+const LayoutUnix = "unix"
+
+// defaultTimeLayouts are tried in order when no time layout is given.
+var defaultTimeLayouts = []string{time.RFC3339, "2006-01-02 15:04:05", "2006-01-02"}
+
+// HeaderMode is whether the first row of the data holds the names of the columns.
+type HeaderMode int
+
+const (
+	// HeaderAuto treats the first row as a header if any of its values (other than in the first column) are not
+	// numbers.
+	HeaderAuto HeaderMode = iota
+	HeaderPresent
+	HeaderAbsent
+)
+
+type LoadOpt func(l *loadConfig)
+
+type loadConfig struct {
+	comma  rune
+	header HeaderMode
+}
+
+// LoadComma sets the character between the fields of delimited data e.g. '\t' for TSV. The default is a comma.
+func LoadComma(comma rune) LoadOpt {
+	return func(l *loadConfig) {
+		l.comma = comma
+	}
+}
+
+// LoadHeader sets whether the first row is a header. The default is HeaderAuto.
+func LoadHeader(mode HeaderMode) LoadOpt {
+	return func(l *loadConfig) {
+		l.header = mode
+	}
+}
+
+// Column selects a column of a DataTable by name or index.
+type Column struct {
+	name  string
+	index int
+}
+
+// ColumnName selects the column with the given name in the header.
+func ColumnName(name string) Column {
+	return Column{name: name, index: -1}
+}
+
+// ColumnIndex selects a column by its position starting at 0.
+func ColumnIndex(i int) Column {
+	return Column{index: i}
+}
+
+func (c Column) String() string {
+	if c.index < 0 {
+		return c.name
+	}
+	return fmt.Sprintf("column %d", c.index)
+}
+
+// RowError is a value that could not be parsed. Row is the position of the row in the input starting at 1 (including
+// the header).
+type RowError struct {
+	Row    int
+	Column string
+	Value  string
+	Msg    string
+}
+
+func (e RowError) Error() string {
+	return fmt.Sprintf("row %d: %s: %s", e.Row, e.Column, e.Msg)
+}
+
+// LoadErrors are all the values of a table that could not be parsed.
+type LoadErrors []RowError
+
+func (e LoadErrors) Error() string {
+	msgs := make([]string, len(e))
+	for k, err := range e {
+		msgs[k] = err.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// DataTable is tabular data loaded from CSV or JSON. Values are kept as text until they are read as numbers or times
+// by the series methods.
+type DataTable struct {
+	header  []string
+	rows    [][]string
+	numbers []int
+}
+
+// LoadCSV reads comma separated (or other delimited, see LoadComma) data. Lines starting with # are ignored.
+func LoadCSV(r io.Reader, opts ...LoadOpt) (*DataTable, error) {
+	cfg := newLoadConfig(opts)
+	cr := csv.NewReader(r)
+	cr.Comma = cfg.comma
+	cr.TrimLeadingSpace = true
+	cr.Comment = '#'
+	cr.FieldsPerRecord = -1
+
+	t := &DataTable{}
+	for {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		t.rows = append(t.rows, record)
+		t.numbers = append(t.numbers, len(t.rows))
+	}
+	t.detectHeader(cfg.header)
+	return t, nil
+}
+
+// LoadJSON reads an array of objects or an array of arrays. The keys of the objects are used as the header in the
+// order they first appear, a key missing from an object is an empty value. With HeaderAbsent the keys only order the
+// columns and the table has no header. Arrays use the header mode in the same way as LoadCSV. Numbers, booleans and
+// nulls are converted to text.
+func LoadJSON(r io.Reader, opts ...LoadOpt) (*DataTable, error) {
+	cfg := newLoadConfig(opts)
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+	if tok, err := dec.Token(); err != nil {
+		return nil, err
+	} else if tok != json.Delim('[') {
+		return nil, fmt.Errorf("expected an array of objects or arrays")
+	}
+
+	t := &DataTable{}
+	objects := false
+	for row := 1; dec.More(); row++ {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		var values []string
+		switch tok {
+		case json.Delim('{'):
+			objects = true
+			values = make([]string, len(t.header))
+			for dec.More() {
+				key, err := dec.Token()
+				if err != nil {
+					return nil, err
+				}
+				var v interface{}
+				if err := dec.Decode(&v); err != nil {
+					return nil, fmt.Errorf("row %d: %w", row, err)
+				}
+				col := indexOfString(t.header, key.(string))
+				if col == -1 {
+					// a new key is a new column, earlier rows are too short to have a value for it.
+					t.header = append(t.header, key.(string))
+					col = len(t.header) - 1
+				}
+				for len(values) <= col {
+					values = append(values, "")
+				}
+				values[col] = jsonText(v)
+			}
+		case json.Delim('['):
+			for dec.More() {
+				var v interface{}
+				if err := dec.Decode(&v); err != nil {
+					return nil, fmt.Errorf("row %d: %w", row, err)
+				}
+				values = append(values, jsonText(v))
+			}
+		default:
+			return nil, fmt.Errorf("row %d: expected an object or array", row)
+		}
+		// the closing brace or bracket.
+		if _, err := dec.Token(); err != nil {
+			return nil, err
+		}
+		t.rows = append(t.rows, values)
+		t.numbers = append(t.numbers, row)
+	}
+	switch {
+	case objects && cfg.header == HeaderAbsent:
+		t.header = nil
+	case !objects:
+		t.detectHeader(cfg.header)
+	}
+	return t, nil
+}
+
+func newLoadConfig(opts []LoadOpt) loadConfig {
+	cfg := loadConfig{comma: ','}
+	for _, o := range opts {
+		o(&cfg)
+	}
+	return cfg
+}
+
+func jsonText(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func (t *DataTable) detectHeader(mode HeaderMode) {
+	if len(t.rows) == 0 || mode == HeaderAbsent {
+		return
+	}
+	isHeader := mode == HeaderPresent
+	if mode == HeaderAuto {
+		first := t.rows[0]
+		for k, v := range first {
+			if k == 0 && len(first) > 1 {
+				continue
+			}
+			if _, ok := parseDataValue(v); !ok {
+				isHeader = true
+				break
+			}
+		}
+	}
+	if isHeader {
+		t.header, t.rows, t.numbers = t.rows[0], t.rows[1:], t.numbers[1:]
+	}
+}
+
+// Header is the names of the columns. It is empty if the data has no header.
+func (t *DataTable) Header() []string {
+	return t.header
+}
+
+// Len is the number of rows not including the header.
+func (t *DataTable) Len() int {
+	return len(t.rows)
+}
+
+// RowNumber is the position of the i-th row in the input starting at 1 (including the header) as used by RowError.
+func (t *DataTable) RowNumber(i int) int {
+	return t.numbers[i]
+}
+
+// NumColumns is the number of columns of the longest row.
+func (t *DataTable) NumColumns() int {
+	n := len(t.header)
+	for _, r := range t.rows {
+		if len(r) > n {
+			n = len(r)
+		}
+	}
+	return n
+}
+
+// Strings returns the text of each row of the column. Rows that are too short have empty values.
+func (t *DataTable) Strings(c Column) ([]string, error) {
+	idx, err := t.Index(c)
+	if err != nil {
+		return nil, err
+	}
+	values := make([]string, len(t.rows))
+	for k, r := range t.rows {
+		if idx < len(r) {
+			values[k] = strings.TrimSpace(r[idx])
+		}
+	}
+	return values, nil
+}
+
+// Values returns the column as numbers. Empty values, null and NaN are missing values (NaN). Values that cannot be
+// parsed are also NaN and are listed in the LoadErrors returned with them.
+func (t *DataTable) Values(c Column) ([]float64, error) {
+	text, err := t.Strings(c)
+	if err != nil {
+		return nil, err
+	}
+	var errs LoadErrors
+	values := make([]float64, len(text))
+	for k, v := range text {
+		f, ok := parseDataValue(v)
+		if !ok {
+			errs = append(errs, RowError{Row: t.numbers[k], Column: t.columnName(c), Value: v, Msg: fmt.Sprintf("cannot parse %q as a finite number", v)})
+		}
+		values[k] = f
+	}
+	return values, errs.orNil()
+}
+
+// Times returns the column as times parsed with the first of the layouts that matches. LayoutUnix parses seconds
+// since the epoch. If no layouts are given RFC 3339, "2006-01-02 15:04:05" and "2006-01-02" are tried. The errors
+// are LoadErrors listing each value that could not be parsed.
+func (t *DataTable) Times(c Column, layouts ...string) ([]time.Time, error) {
+	text, err := t.Strings(c)
+	if err != nil {
+		return nil, err
+	}
+	if len(layouts) == 0 {
+		layouts = defaultTimeLayouts
+	}
+	quoted := make([]string, len(layouts))
+	for k, l := range layouts {
+		quoted[k] = strconv.Quote(l)
+	}
+	var errs LoadErrors
+	times := make([]time.Time, len(text))
+	for k, v := range text {
+		tm, ok := parseDataTime(v, layouts)
+		if !ok {
+			errs = append(errs, RowError{Row: t.numbers[k], Column: t.columnName(c), Value: v, Msg: fmt.Sprintf("cannot parse %q as a time with layout %s", v, strings.Join(quoted, " or "))})
+		}
+		times[k] = tm
+	}
+	return times, errs.orNil()
+}
+
+// XYSeries creates a series with labels from the X column for each of the Y columns. If no Y columns are given every
+// column other than X is used. Values that cannot be parsed are missing (NaN) and listed in the LoadErrors returned
+// with the series.
+func (t *DataTable) XYSeries(x Column, ys ...Column) ([]Series, error) {
+	labels, err := t.Strings(x)
+	if err != nil {
+		return nil, err
+	}
+	return t.series(x, ys, nil, func(keep []int, y []float64) Series {
+		return NewXYSeries(pickStrings(labels, keep), y)
+	})
+}
+
+// NumericSeries creates a series with numeric X values for each of the Y columns for use with a continuous X scale.
+// Rows where the X value cannot be parsed are left out of every series.
+func (t *DataTable) NumericSeries(x Column, ys ...Column) ([]Series, error) {
+	xs, err := t.Values(x)
+	errs, ok := err.(LoadErrors)
+	if err != nil && !ok {
+		return nil, err
+	}
+	return t.series(x, ys, errs, func(keep []int, y []float64) Series {
+		return NewNumericSeries(pickFloats(xs, keep), y)
+	})
+}
+
+// TimeSeries creates a time series for each of the Y columns with times parsed from the X column (see Times). Rows
+// where the time cannot be parsed are left out of every series.
+func (t *DataTable) TimeSeries(x Column, layouts []string, ys ...Column) ([]Series, error) {
+	times, err := t.Times(x, layouts...)
+	errs, ok := err.(LoadErrors)
+	if err != nil && !ok {
+		return nil, err
+	}
+	return t.series(x, ys, errs, func(keep []int, y []float64) Series {
+		picked := make([]time.Time, len(keep))
+		for k, i := range keep {
+			picked[k] = times[i]
+		}
+		return NewTimeSeries(picked, y)
+	})
+}
+
+// series creates a series for each Y column leaving out the rows with errors in the X column.
+func (t *DataTable) series(x Column, ys []Column, xErrs LoadErrors, create func(keep []int, y []float64) Series) ([]Series, error) {
+	xIdx, err := t.Index(x)
+	if err != nil {
+		return nil, err
+	}
+	if len(ys) == 0 {
+		for k := 0; k < t.NumColumns(); k++ {
+			if k != xIdx {
+				ys = append(ys, ColumnIndex(k))
+			}
+		}
+	}
+
+	bad := map[int]bool{}
+	for _, e := range xErrs {
+		bad[e.Row] = true
+	}
+	var keep []int
+	for k := range t.rows {
+		if !bad[t.numbers[k]] {
+			keep = append(keep, k)
+		}
+	}
+
+	errs := xErrs
+	series := make([]Series, len(ys))
+	for k, c := range ys {
+		values, err := t.Values(c)
+		if yErrs, ok := err.(LoadErrors); ok {
+			errs = append(errs, yErrs...)
+		} else if err != nil {
+			return nil, err
+		}
+		series[k] = create(keep, pickFloats(values, keep))
+	}
+	return series, errs.orNil()
+}
+
+// Index is the position of the column. It is an error if the column does not exist.
+func (t *DataTable) Index(c Column) (int, error) {
+	if c.index >= 0 || c.name == "" {
+		if c.index < 0 || c.index >= t.NumColumns() {
+			return 0, fmt.Errorf("column %d does not exist: the data has %d columns", c.index, t.NumColumns())
+		}
+		return c.index, nil
+	}
+	if idx := indexOfString(t.header, c.name); idx != -1 {
+		return idx, nil
+	}
+	return 0, fmt.Errorf("column %q does not exist", c.name)
+}
+
+// columnName is the name of the column in the header if it has one.
+func (t *DataTable) columnName(c Column) string {
+	if idx, err := t.Index(c); err == nil && idx < len(t.header) {
+		return t.header[idx]
+	}
+	return c.String()
+}
+
+func (e LoadErrors) orNil() error {
+	if len(e) == 0 {
+		return nil
+	}
+	return e
+}
+
+// parseDataValue parses a number. Empty values, null and NaN are missing values. Infinity cannot be plotted so it is
+// not a valid number.
+func parseDataValue(v string) (float64, bool) {
+	v = strings.TrimSpace(v)
+	switch strings.ToLower(v) {
+	case "", "null", "nan":
+		return math.NaN(), true
+	}
+	f, err := strconv.ParseFloat(v, 64)
+	if err != nil || math.IsInf(f, 0) {
+		return math.NaN(), false
+	}
+	return f, true
+}
+
+func parseDataTime(v string, layouts []string) (time.Time, bool) {
+	for _, layout := range layouts {
+		if layout == LayoutUnix {
+			if secs, err := strconv.ParseFloat(v, 64); err == nil && !math.IsInf(secs, 0) && !math.IsNaN(secs) {
+				whole, frac := math.Modf(secs)
+				return time.Unix(int64(whole), int64(frac*1e9)).UTC(), true
+			}
+			continue
+		}
+		if tm, err := time.Parse(layout, v); err == nil {
+			return tm, true
+		}
+	}
+	return time.Time{}, false
+}
+
+func pickStrings(values []string, keep []int) []string {
+	picked := make([]string, len(keep))
+	for k, i := range keep {
+		picked[k] = values[i]
+	}
+	return picked
+}
+
+func pickFloats(values []float64, keep []int) []float64 {
+	picked := make([]float64, len(keep))
+	for k, i := range keep {
+		picked[k] = values[i]
+	}
+	return picked
+}
+
+func indexOfString(ss []string, s string) int {
+	for k, v := range ss {
+		if v == s {
+			return k
+		}
+	}
+	return -1
+}
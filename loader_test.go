@@ -0,0 +1,30 @@
+package gochart
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestLoadCSVInfinity(t *testing.T) {
+	table, err := LoadCSV(strings.NewReader("x,y\na,1\nb,inf\nc,-Infinity\nd,3\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	values, err := table.Values(ColumnName("y"))
+	errs, ok := err.(LoadErrors)
+	if !ok || len(errs) != 2 {
+		t.Fatalf("expected 2 load errors, got %v", err)
+	}
+	if errs[0].Row != 3 || errs[1].Row != 4 {
+		t.Fatalf("expected errors on rows 3 and 4, got %d and %d", errs[0].Row, errs[1].Row)
+	}
+	for k, v := range values {
+		if math.IsInf(v, 0) {
+			t.Fatalf("expected value %d to be missing, got %v", k, v)
+		}
+	}
+	if !math.IsNaN(values[1]) || !math.IsNaN(values[2]) || values[3] != 3 {
+		t.Fatalf("unexpected values %v", values)
+	}
+}
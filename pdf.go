@@ -0,0 +1,274 @@
+package gochart
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/golang/freetype/truetype"
+	"github.com/jung-kurt/gofpdf"
+	"golang.org/x/image/font"
+	"golang.org/x/image/math/fixed"
+)
+
+// the width of every glyph in the PDF core Courier font as a fraction of the font size.
+const courierAdvance = 0.6
+
+type PDFOpt func(r *PDFRenderer)
+
+// PDFFontFace registers the TrueType data a font face was created from. Text drawn using the face (e.g. via
+// style.FontFace) is then embedded in the document using that font. Text drawn with any other face falls back
+// to the PDF core Courier font scaled to match the face's advance width, which is exact for monospaced faces such
+// as the gg default.
+func PDFFontFace(face font.Face, ttf []byte) PDFOpt {
+	return func(r *PDFRenderer) {
+		f, err := truetype.Parse(ttf)
+		if err != nil {
+			r.setErr(fmt.Errorf("failed to parse font: %w", err))
+			return
+		}
+		family := fmt.Sprintf("face%d", len(r.fonts)+1)
+		r.pdf.AddUTF8FontFromBytes(family, "", ttf)
+		r.fonts[face] = pdfFont{family: family, size: truetypeFaceSize(face, f)}
+	}
+}
+
+type pdfFont struct {
+	family string
+	size   float64
+}
+
+// NewPDFRenderer creates a Renderer that draws to a PDF document with a single page of the given size. One pixel
+// of the canvas is one point in the document. Further pages can be started with AddPage.
+func NewPDFRenderer(width, height int, opts ...PDFOpt) *PDFRenderer {
+	pdf := gofpdf.NewCustom(&gofpdf.InitType{
+		OrientationStr: "P",
+		UnitStr:        "pt",
+		Size:           gofpdf.SizeType{Wd: float64(width), Ht: float64(height)},
+	})
+	pdf.SetAutoPageBreak(false, 0)
+	pdf.SetMargins(0, 0, 0)
+
+	r := &PDFRenderer{
+		vectorCanvas: newVectorCanvas(width, height),
+		pdf:          pdf,
+		fonts:        map[font.Face]pdfFont{},
+	}
+	for _, o := range opts {
+		o(r)
+	}
+	r.AddPage()
+	return r
+}
+
+// PDFRenderer is a vector Renderer producing a PDF document.
+type PDFRenderer struct {
+	vectorCanvas
+	pdf   *gofpdf.Fpdf
+	fonts map[font.Face]pdfFont
+	clip  [][]subPath
+	err   error
+}
+
+var _ Renderer = (*PDFRenderer)(nil)
+
+// AddPage starts a new page of the same size. Everything drawn afterwards will appear on the new page. The drawing
+// state, path and clip are reset.
+func (r *PDFRenderer) AddPage() {
+	r.vectorCanvas = newVectorCanvas(r.width, r.height)
+	r.clip = nil
+	r.pdf.AddPage()
+	r.pdf.SetLineCapStyle("round")
+	r.pdf.SetLineJoinStyle("round")
+}
+
+func (r *PDFRenderer) Fill() {
+	r.fill(false)
+}
+
+func (r *PDFRenderer) FillPreserve() {
+	r.fill(true)
+}
+
+func (r *PDFRenderer) Stroke() {
+	r.stroke(false)
+}
+
+func (r *PDFRenderer) StrokePreserve() {
+	r.stroke(true)
+}
+
+// Clip restricts all further drawing to the current path. Like gg, the clip is not restored by Pop and must be
+// cleared with ResetClip.
+func (r *PDFRenderer) Clip() {
+	r.clip = append(r.clip, r.takePath(false))
+}
+
+func (r *PDFRenderer) ResetClip() {
+	r.clip = nil
+}
+
+func (r *PDFRenderer) DrawString(s string, x, y float64) {
+	r.DrawStringAnchored(s, x, y, 0, 0)
+}
+
+func (r *PDFRenderer) DrawStringAnchored(s string, x, y, ax, ay float64) {
+	c := nrgba(r.state.color)
+	if s == "" || c.A == 0 {
+		return
+	}
+	x, y = r.anchorString(s, x, y, ax, ay)
+
+	if f, ok := r.fonts[r.state.fontFace]; ok {
+		r.pdf.SetFont(f.family, "", f.size)
+	} else {
+		advance, _ := r.state.fontFace.GlyphAdvance('0')
+		r.pdf.SetFont("Courier", "", float64(advance)/64/courierAdvance)
+	}
+	r.pdf.SetTextColor(int(c.R), int(c.G), int(c.B))
+	r.pdf.SetAlpha(float64(c.A)/255, "Normal")
+
+	r.beginClip()
+	if r.isTransformed() {
+		// the transform is defined in canvas space where Y grows downwards so it needs to be flipped to apply
+		// to PDF user space.
+		m := r.state.matrix
+		h := float64(r.height)
+		r.pdf.TransformBegin()
+		r.pdf.Transform(gofpdf.TransformMatrix{
+			A: m.XX,
+			B: -m.YX,
+			C: -m.XY,
+			D: m.YY,
+			E: m.XY*h + m.X0,
+			F: h - m.YY*h - m.Y0,
+		})
+		r.pdf.Text(x, y, s)
+		r.pdf.TransformEnd()
+	} else {
+		r.pdf.Text(x, y, s)
+	}
+	r.endClip()
+}
+
+// EncodePDF writes the complete document to the given writer. Any error encountered while drawing is returned
+// here.
+func (r *PDFRenderer) EncodePDF(w io.Writer) error {
+	if r.err != nil {
+		return r.err
+	}
+	return r.pdf.Output(w)
+}
+
+// SavePDF writes the document to the given path.
+func (r *PDFRenderer) SavePDF(path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := r.EncodePDF(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+func (r *PDFRenderer) fill(preserve bool) {
+	path := r.takePath(preserve)
+	c := nrgba(r.state.color)
+	if len(path) == 0 || c.A == 0 {
+		return
+	}
+	r.pdf.SetFillColor(int(c.R), int(c.G), int(c.B))
+	r.pdf.SetAlpha(float64(c.A)/255, "Normal")
+
+	r.beginClip()
+	r.writePath(path, true)
+	r.pdf.DrawPath("f")
+	r.endClip()
+}
+
+func (r *PDFRenderer) stroke(preserve bool) {
+	path := r.takePath(preserve)
+	c := nrgba(r.state.color)
+	if len(path) == 0 || c.A == 0 || r.state.lineWidth <= 0 {
+		return
+	}
+	r.pdf.SetDrawColor(int(c.R), int(c.G), int(c.B))
+	r.pdf.SetAlpha(float64(c.A)/255, "Normal")
+	r.pdf.SetLineWidth(r.state.lineWidth)
+	r.pdf.SetDashPattern(r.state.dashes, 0)
+
+	r.beginClip()
+	r.writePath(path, false)
+	r.pdf.DrawPath("S")
+	r.endClip()
+}
+
+func (r *PDFRenderer) writePath(path []subPath, fill bool) {
+	for _, sp := range path {
+		for k, p := range sp.points {
+			if k == 0 {
+				r.pdf.MoveTo(p.X, p.Y)
+			} else {
+				r.pdf.LineTo(p.X, p.Y)
+			}
+		}
+		if sp.closed || fill {
+			r.pdf.ClosePath()
+		}
+	}
+}
+
+// beginClip saves the graphics state and applies the clip for a single drawing operation. All other state must
+// be set before calling this as it will be discarded by endClip.
+func (r *PDFRenderer) beginClip() {
+	if len(r.clip) == 0 {
+		return
+	}
+	h := float64(r.height)
+	ops := []string{"q"}
+	for _, path := range r.clip {
+		for _, sp := range path {
+			for k, p := range sp.points {
+				op := "l"
+				if k == 0 {
+					op = "m"
+				}
+				ops = append(ops, fmt.Sprintf("%.2f %.2f %s", p.X, h-p.Y, op))
+			}
+			ops = append(ops, "h")
+		}
+		ops = append(ops, "W n")
+	}
+	r.pdf.RawWriteStr(strings.Join(ops, " "))
+}
+
+func (r *PDFRenderer) endClip() {
+	if len(r.clip) == 0 {
+		return
+	}
+	r.pdf.RawWriteStr("Q")
+}
+
+func (r *PDFRenderer) setErr(err error) {
+	if r.err == nil {
+		r.err = err
+	}
+}
+
+// truetypeFaceSize finds the size (in pixels per em) the face was created with by comparing its glyph advance
+// with the unscaled advance in the font.
+func truetypeFaceSize(face font.Face, f *truetype.Font) float64 {
+	unitsPerEm := f.FUnitsPerEm()
+	for _, r := range "0xM" {
+		idx := f.Index(r)
+		unscaled := f.HMetric(fixed.Int26_6(unitsPerEm), idx).AdvanceWidth
+		advance, ok := face.GlyphAdvance(r)
+		if idx != 0 && ok && unscaled > 0 {
+			return float64(advance) / 64 * float64(unitsPerEm) / float64(unscaled)
+		}
+	}
+	return float64(face.Metrics().Height) / 64
+}
@@ -0,0 +1,377 @@
+package gochart
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+	"sort"
+
+	"github.com/warmans/gochart/pkg/style"
+)
+
+// the length of each part of the leader line drawn to an outside label.
+const pieLeaderLength = 12
+
+// PieLabelPosition is where the labels of a pie are drawn.
+type PieLabelPosition int
+
+const (
+	PieLabelsOutside PieLabelPosition = iota
+	PieLabelsInside
+	PieLabelsNone
+)
+
+type PieOpt func(p *Pie)
+
+// PieStartAngle sets the angle in degrees clockwise from 12 o'clock where the first slice starts.
+func PieStartAngle(degrees float64) PieOpt {
+	return func(p *Pie) {
+		p.startAngle = degrees
+	}
+}
+
+// PieInnerRadius sets the radius of the hole in the middle as a proportion of the radius of the pie e.g. 0.5 for a
+// donut with a hole half the size of the chart.
+func PieInnerRadius(ratio float64) PieOpt {
+	return func(p *Pie) {
+		p.innerRadius = math.Min(math.Max(ratio, 0), 0.95)
+	}
+}
+
+// PiePadding sets the width of the gap between slices in pixels.
+func PiePadding(padding float64) PieOpt {
+	return func(p *Pie) {
+		p.padding = padding
+	}
+}
+
+// PieExplode moves the i-th slice away from the center by the given distance in pixels.
+func PieExplode(i int, offset float64) PieOpt {
+	return func(p *Pie) {
+		p.explode[i] = offset
+	}
+}
+
+// PieLabels sets where the labels are drawn. Outside labels are connected to their slice by a leader line.
+func PieLabels(pos PieLabelPosition) PieOpt {
+	return func(p *Pie) {
+		p.labelPosition = pos
+	}
+}
+
+// PieLabelFn sets the text of the label of each slice from its name, value and share of the total (between 0 and 1).
+func PieLabelFn(fn func(name string, v, share float64) string) PieOpt {
+	return func(p *Pie) {
+		p.labelFn = fn
+	}
+}
+
+// PieSliceStyles sets the style of the i-th slice.
+func PieSliceStyles(i int, opt ...style.Opt) PieOpt {
+	return func(p *Pie) {
+		if i >= 0 && i < len(p.sliceStyles) {
+			p.sliceStyles[i].SetStyle(opt...)
+		}
+	}
+}
+
+// PieFontStyles sets the style of the slice labels.
+func PieFontStyles(opt ...style.Opt) PieOpt {
+	return func(p *Pie) {
+		p.fontStyles.SetStyle(opt...)
+	}
+}
+
+// PieLineStyles sets the style of the leader lines drawn to outside labels.
+func PieLineStyles(opt ...style.Opt) PieOpt {
+	return func(p *Pie) {
+		p.lineStyles.SetStyle(opt...)
+	}
+}
+
+// NewPie creates a pie chart with a slice for each point of the series. The X values of the series are used as the
+// names of the slices. Negative, infinite and NaN values are not drawn.
+func NewPie(s Series, opts ...PieOpt) *Pie {
+	p := &Pie{
+		s:           s,
+		explode:     map[int]float64{},
+		sliceStyles: make([]Styles, len(s.Ys())),
+		fontStyles:  newThemeStyles(DefaultTheme.FontStyles),
+		lineStyles:  NewStyles(style.Color(color.RGBA{R: 100, G: 100, B: 100, A: 255}), style.LineWidth(1)),
+		labelFn: func(name string, v, share float64) string {
+			return fmt.Sprintf("%s (%0.0f%%)", name, share*100)
+		},
+	}
+	for k := range p.sliceStyles {
+		p.sliceStyles[k] = newThemeStyles(style.Opts{style.Color(DefaultTheme.Palette.Color(k))})
+	}
+	for _, o := range opts {
+		o(p)
+	}
+	return p
+}
+
+// NewDonut creates a pie chart with a hole in the middle. The size of the hole can be changed with PieInnerRadius.
+func NewDonut(s Series, opts ...PieOpt) *Pie {
+	return NewPie(s, append([]PieOpt{PieInnerRadius(0.5)}, opts...)...)
+}
+
+type Pie struct {
+	s             Series
+	startAngle    float64
+	innerRadius   float64
+	padding       float64
+	explode       map[int]float64
+	labelPosition PieLabelPosition
+	labelFn       func(name string, v, share float64) string
+	sliceStyles   []Styles
+	fontStyles    Styles
+	lineStyles    Styles
+}
+
+// LegendEntries creates a legend entry for each slice.
+func (p *Pie) LegendEntries() []LegendEntry {
+	entries := make([]LegendEntry, len(p.sliceStyles))
+	for k := range p.sliceStyles {
+		slice := &p.sliceStyles[k]
+		entries[k] = LegendEntry{Name: p.s.X(k), Swatch: SwatchBar, Styles: style.Opts{func(canvas style.Canvas) {
+			slice.styles().Apply(canvas)
+		}}}
+	}
+	return entries
+}
+
+type pieSlice struct {
+	index  int
+	value  float64
+	share  float64
+	start  float64
+	end    float64
+	center [2]float64
+}
+
+func (s pieSlice) mid() float64 {
+	return (s.start + s.end) / 2
+}
+
+type pieLabel struct {
+	text   string
+	slice  pieSlice
+	right  bool
+	anchor [2]float64
+	y      float64
+}
+
+func (p *Pie) Render(canvas Renderer, b BoundingBox) error {
+
+	canvas.Push()
+	defer canvas.Pop()
+
+	p.fontStyles.styleOpts.Apply(canvas)
+
+	slices := p.slices()
+	if len(slices) == 0 {
+		return nil
+	}
+
+	maxExplode := 0.0
+	for _, offset := range p.explode {
+		maxExplode = math.Max(maxExplode, offset)
+	}
+
+	// outside labels need space to the sides for the text and above and below for the leader lines.
+	leftW, rightW, labelH := 0.0, 0.0, 0.0
+	if p.labelPosition == PieLabelsOutside {
+		for _, s := range slices {
+			w, _ := canvas.MeasureString(p.label(s))
+			w += pieLeaderLength*2 + defaultMargin/2
+			if math.Cos(s.mid()) >= 0 {
+				rightW = math.Max(rightW, w)
+			} else {
+				leftW = math.Max(leftW, w)
+			}
+		}
+		labelH = pieLeaderLength + canvas.FontHeight()
+	}
+	radius := math.Min((b.W-leftW-rightW)/2, b.H/2-labelH) - maxExplode - defaultMargin
+	if radius <= 0 {
+		return nil
+	}
+	innerRadius := radius * p.innerRadius
+
+	cx, cy := b.RelX(leftW+(b.W-leftW-rightW)/2), b.RelY(b.H/2)
+	for k, s := range slices {
+		offset := p.explode[s.index]
+		slices[k].center = [2]float64{cx + math.Cos(s.mid())*offset, cy + math.Sin(s.mid())*offset}
+	}
+
+	for _, s := range slices {
+		canvas.Push()
+		p.sliceStyles[s.index].styleOpts.Apply(canvas)
+		p.drawSlice(canvas, s, radius, innerRadius)
+		canvas.Fill()
+		canvas.Pop()
+	}
+
+	switch p.labelPosition {
+	case PieLabelsInside:
+		labelRadius := (radius + innerRadius) / 2
+		if innerRadius == 0 {
+			labelRadius = radius * 0.6
+		}
+		for _, s := range slices {
+			canvas.DrawStringAnchored(
+				p.label(s),
+				s.center[0]+math.Cos(s.mid())*labelRadius,
+				s.center[1]+math.Sin(s.mid())*labelRadius,
+				0.5,
+				0.35,
+			)
+		}
+	case PieLabelsOutside:
+		p.drawOutsideLabels(canvas, slices, radius, b)
+	}
+
+	return nil
+}
+
+// drawSlice adds the outline of the slice to the path. The edges are moved in by half the padding so the gap between
+// neighbouring slices has the same width all the way along.
+func (p *Pie) drawSlice(canvas Renderer, s pieSlice, radius, innerRadius float64) {
+	halfAngle := (s.end - s.start) / 2
+	trim := func(r float64) float64 {
+		if r <= 0 || p.padding <= 0 {
+			return 0
+		}
+		return math.Min(math.Asin(math.Min(p.padding/2/r, 1)), halfAngle)
+	}
+
+	cx, cy := s.center[0], s.center[1]
+	canvas.NewSubPath()
+	if innerRadius > 0 {
+		t := trim(radius)
+		canvas.DrawArc(cx, cy, radius, s.start+t, s.end-t)
+		t = trim(innerRadius)
+		canvas.DrawArc(cx, cy, innerRadius, s.end-t, s.start+t)
+	} else {
+		// the point of the slice moves out along its middle so both edges stay half the padding from the center.
+		apex := 0.0
+		if p.padding > 0 && halfAngle < math.Pi/2 {
+			apex = math.Min(p.padding/2/math.Sin(halfAngle), radius/2)
+		}
+		canvas.MoveTo(cx+math.Cos(s.mid())*apex, cy+math.Sin(s.mid())*apex)
+		t := trim(radius)
+		canvas.DrawArc(cx, cy, radius, s.start+t, s.end-t)
+	}
+	canvas.ClosePath()
+}
+
+// drawOutsideLabels draws labels to the left and right of the pie connected to their slices by leader lines. Labels
+// on each side are spread out so they do not overlap.
+func (p *Pie) drawOutsideLabels(canvas Renderer, slices []pieSlice, radius float64, b BoundingBox) {
+	var left, right []*pieLabel
+	for _, s := range slices {
+		l := &pieLabel{
+			text:  p.label(s),
+			slice: s,
+			right: math.Cos(s.mid()) >= 0,
+			anchor: [2]float64{
+				s.center[0] + math.Cos(s.mid())*radius,
+				s.center[1] + math.Sin(s.mid())*radius,
+			},
+		}
+		l.y = s.center[1] + math.Sin(s.mid())*(radius+pieLeaderLength)
+		if l.right {
+			right = append(right, l)
+		} else {
+			left = append(left, l)
+		}
+	}
+
+	for _, side := range [][]*pieLabel{left, right} {
+		spreadLabels(side, canvas.FontHeight(), b.RelY(canvas.FontHeight()/2), b.RelY(b.H-canvas.FontHeight()/2))
+
+		for _, l := range side {
+			cx := l.slice.center[0]
+			elbowX := cx + math.Cos(l.slice.mid())*(radius+pieLeaderLength)
+			textX, anchorX := elbowX+pieLeaderLength, 0.0
+			if !l.right {
+				textX, anchorX = elbowX-pieLeaderLength, 1.0
+			}
+
+			canvas.Push()
+			p.lineStyles.styleOpts.Apply(canvas)
+			canvas.MoveTo(l.anchor[0], l.anchor[1])
+			canvas.LineTo(elbowX, l.y)
+			canvas.LineTo(textX, l.y)
+			canvas.Stroke()
+			canvas.Pop()
+
+			padding := defaultMargin / 4
+			if !l.right {
+				padding = -padding
+			}
+			canvas.DrawStringAnchored(l.text, textX+padding, l.y, anchorX, 0.35)
+		}
+	}
+}
+
+// spreadLabels moves the labels apart so each is at least the given height from the next while staying between
+// the top and bottom.
+func spreadLabels(labels []*pieLabel, height, top, bottom float64) {
+	sort.Slice(labels, func(i, j int) bool {
+		return labels[i].y < labels[j].y
+	})
+	for k, l := range labels {
+		l.y = math.Max(l.y, top)
+		if k > 0 {
+			l.y = math.Max(l.y, labels[k-1].y+height)
+		}
+	}
+	for k := len(labels) - 1; k >= 0; k-- {
+		l := labels[k]
+		l.y = math.Min(l.y, bottom)
+		if k < len(labels)-1 {
+			l.y = math.Min(l.y, labels[k+1].y-height)
+		}
+	}
+}
+
+func (p *Pie) label(s pieSlice) string {
+	return p.labelFn(p.s.X(s.index), s.value, s.share)
+}
+
+// slices calculates the angles and shares of each slice. Angles are in radians clockwise from 3 o'clock as used by
+// DrawArc. Values that are not positive and finite are skipped.
+func (p *Pie) slices() []pieSlice {
+	// values are divided by the largest before they are summed so a very large total cannot overflow.
+	largest := 0.0
+	for _, v := range p.s.Ys() {
+		if pieValue(v) {
+			largest = math.Max(largest, v)
+		}
+	}
+	total := 0.0
+	for _, v := range p.s.Ys() {
+		if pieValue(v) {
+			total += v / largest
+		}
+	}
+	slices := []pieSlice{}
+	angle := (p.startAngle - 90) * math.Pi / 180
+	for k, v := range p.s.Ys() {
+		if !pieValue(v) {
+			continue
+		}
+		share := v / largest / total
+		sweep := share * 2 * math.Pi
+		slices = append(slices, pieSlice{index: k, value: v, share: share, start: angle, end: angle + sweep})
+		angle += sweep
+	}
+	return slices
+}
+
+// pieValue reports whether the value can be drawn as a slice.
+func pieValue(v float64) bool {
+	return v > 0 && !math.IsInf(v, 0)
+}
@@ -0,0 +1,35 @@
+package gochart
+
+import (
+	"math"
+	"testing"
+)
+
+func TestPieSlicesExtremeValues(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []float64
+		shares []float64
+	}{
+		{name: "huge values", values: []float64{1e308, 1e308, 1e308}, shares: []float64{1.0 / 3, 1.0 / 3, 1.0 / 3}},
+		{name: "infinite", values: []float64{math.Inf(1), 1, 3}, shares: []float64{0.25, 0.75}},
+		{name: "NaN and negative", values: []float64{math.NaN(), -1, 2, 2}, shares: []float64{0.5, 0.5}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			slices := NewPie(NewYSeries(test.values)).slices()
+			if len(slices) != len(test.shares) {
+				t.Fatalf("expected %d slices, got %d", len(test.shares), len(slices))
+			}
+			for k, s := range slices {
+				if math.Abs(s.share-test.shares[k]) > 1e-9 {
+					t.Fatalf("expected slice %d to have share %v, got %v", k, test.shares[k], s.share)
+				}
+			}
+			end := slices[len(slices)-1].end - slices[0].start
+			if math.Abs(end-2*math.Pi) > 1e-9 {
+				t.Fatalf("expected slices to cover the full circle, got %v", end)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package style
+
+import (
+	"image/color"
+	"math"
+)
+
+// ColorScale maps a value between 0 and 1 to a colour e.g. for the cells of a heatmap.
+type ColorScale func(t float64) color.RGBA
+
+// NewColorScale creates a scale blending between evenly spaced colour stops. Two stops give a sequential scale from
+// one colour to the other and three stops with a neutral middle colour give a diverging scale.
+func NewColorScale(stops ...color.RGBA) ColorScale {
+	return func(t float64) color.RGBA {
+		if len(stops) == 0 {
+			return color.RGBA{A: 255}
+		}
+		if len(stops) == 1 || math.IsNaN(t) {
+			return stops[0]
+		}
+		t = math.Min(math.Max(t, 0), 1) * float64(len(stops)-1)
+		k := int(math.Min(math.Floor(t), float64(len(stops)-2)))
+		from, to, frac := stops[k], stops[k+1], t-float64(k)
+		mix := func(a, b uint8) uint8 {
+			return uint8(math.Round(float64(a) + (float64(b)-float64(a))*frac))
+		}
+		return color.RGBA{R: mix(from.R, to.R), G: mix(from.G, to.G), B: mix(from.B, to.B), A: mix(from.A, to.A)}
+	}
+}
+
+// Viridis is a perceptually uniform sequential scale from dark purple to yellow.
+var Viridis = NewColorScale(
+	color.RGBA{R: 68, G: 1, B: 84, A: 255},
+	color.RGBA{R: 71, G: 45, B: 123, A: 255},
+	color.RGBA{R: 59, G: 82, B: 139, A: 255},
+	color.RGBA{R: 44, G: 114, B: 142, A: 255},
+	color.RGBA{R: 33, G: 145, B: 140, A: 255},
+	color.RGBA{R: 40, G: 174, B: 128, A: 255},
+	color.RGBA{R: 94, G: 201, B: 98, A: 255},
+	color.RGBA{R: 173, G: 220, B: 48, A: 255},
+	color.RGBA{R: 253, G: 231, B: 37, A: 255},
+)
+
+// Magma is a perceptually uniform sequential scale from black through purple and orange to pale yellow.
+var Magma = NewColorScale(
+	color.RGBA{R: 0, G: 0, B: 4, A: 255},
+	color.RGBA{R: 28, G: 16, B: 68, A: 255},
+	color.RGBA{R: 79, G: 18, B: 123, A: 255},
+	color.RGBA{R: 129, G: 37, B: 129, A: 255},
+	color.RGBA{R: 181, G: 54, B: 122, A: 255},
+	color.RGBA{R: 229, G: 80, B: 100, A: 255},
+	color.RGBA{R: 251, G: 135, B: 97, A: 255},
+	color.RGBA{R: 254, G: 194, B: 135, A: 255},
+	color.RGBA{R: 252, G: 253, B: 191, A: 255},
+)
+
+// BlueRed is a diverging scale from blue through white to red for values either side of a midpoint.
+var BlueRed = NewColorScale(
+	color.RGBA{R: 33, G: 102, B: 172, A: 255},
+	color.RGBA{R: 247, G: 247, B: 247, A: 255},
+	color.RGBA{R: 178, G: 24, B: 43, A: 255},
+)
@@ -0,0 +1,51 @@
+package style
+
+import "image/color"
+
+// Palette is a list of colours given to plots in order.
+type Palette []color.RGBA
+
+// Color returns the i-th colour of the palette. Colours are reused from the start once every colour has been used.
+func (p Palette) Color(i int) color.RGBA {
+	if len(p) == 0 {
+		return color.RGBA{A: 255}
+	}
+	if i < 0 {
+		i = -i
+	}
+	return p[i%len(p)]
+}
+
+// Categorical is a general purpose palette of ten distinct colours.
+var Categorical = Palette{
+	{R: 78, G: 121, B: 167, A: 255},
+	{R: 242, G: 142, B: 43, A: 255},
+	{R: 225, G: 87, B: 89, A: 255},
+	{R: 118, G: 183, B: 178, A: 255},
+	{R: 89, G: 161, B: 79, A: 255},
+	{R: 237, G: 201, B: 72, A: 255},
+	{R: 176, G: 122, B: 161, A: 255},
+	{R: 255, G: 157, B: 167, A: 255},
+	{R: 156, G: 117, B: 95, A: 255},
+	{R: 186, G: 176, B: 172, A: 255},
+}
+
+// ColorBlindSafe is a palette that stays distinguishable with the common forms of colour blindness (Okabe-Ito).
+var ColorBlindSafe = Palette{
+	{R: 0, G: 114, B: 178, A: 255},
+	{R: 230, G: 159, B: 0, A: 255},
+	{R: 0, G: 158, B: 115, A: 255},
+	{R: 213, G: 94, B: 0, A: 255},
+	{R: 86, G: 180, B: 233, A: 255},
+	{R: 204, G: 121, B: 167, A: 255},
+	{R: 240, G: 228, B: 66, A: 255},
+}
+
+// HighContrast is a small palette of colours that differ strongly in brightness so they remain distinct when printed
+// in greyscale.
+var HighContrast = Palette{
+	{R: 0, G: 68, B: 136, A: 255},
+	{R: 221, G: 170, B: 51, A: 255},
+	{R: 187, G: 85, B: 102, A: 255},
+	{R: 0, G: 0, B: 0, A: 255},
+}
@@ -1,16 +1,22 @@
 package style
 
 import (
-	"golang.org/x/image/font"
+	"fmt"
 	"image/color"
 	"math/rand"
+	"strconv"
+	"strings"
 
-	"github.com/fogleman/gg"
+	"golang.org/x/image/font"
 )
 
+// DefaultPlotOpts gives a plot a random colour.
+//
+// Deprecated: plots are given colours in order from the palette of a theme so charts look the same every time they
+// are rendered. Use a Palette instead.
 var DefaultPlotOpts = Opts{
 	// set a default random volume for bar fills. This can be overwritten by other options.
-	func(canvas *gg.Context) {
+	func(canvas Canvas) {
 		canvas.SetColor(RandomColor())
 	},
 }
@@ -20,40 +26,82 @@ var DefaultAxisOpts = Opts{
 	LineWidth(2),
 }
 
-type Opt func(canvas *gg.Context)
+// Canvas is the part of a drawing surface that style options are able to modify.
+type Canvas interface {
+	SetColor(c color.Color)
+	SetDash(dashes ...float64)
+	SetLineWidth(lineWidth float64)
+	SetFontFace(fontFace font.Face)
+}
+
+// GradientCanvas is implemented by canvases that are able to fill shapes with a gradient.
+type GradientCanvas interface {
+	Canvas
+	SetGradient(top, bottom color.Color)
+}
+
+type Opt func(canvas Canvas)
 
 type Opts []Opt
 
-func (s Opts) Apply(canvas *gg.Context) {
+func (s Opts) Apply(canvas Canvas) {
 	for _, o := range s {
 		o(canvas)
 	}
 }
 
 func Color(rgba color.RGBA) Opt {
-	return func(canvas *gg.Context) {
+	return func(canvas Canvas) {
 		canvas.SetColor(rgba)
 	}
 }
 
+// VerticalGradient fills shapes with a gradient from the top colour to the bottom colour. Canvases that do not
+// support gradients use the top colour.
+func VerticalGradient(top, bottom color.RGBA) Opt {
+	return func(canvas Canvas) {
+		if gc, ok := canvas.(GradientCanvas); ok {
+			gc.SetGradient(top, bottom)
+			return
+		}
+		canvas.SetColor(top)
+	}
+}
+
 func Dash(dashes ...float64) Opt {
-	return func(canvas *gg.Context) {
+	return func(canvas Canvas) {
 		canvas.SetDash(dashes...)
 	}
 }
 
 func LineWidth(width float64) Opt {
-	return func(canvas *gg.Context) {
+	return func(canvas Canvas) {
 		canvas.SetLineWidth(width)
 	}
 }
 
 func FontFace(fontFace font.Face) Opt {
-	return func(canvas *gg.Context) {
+	return func(canvas Canvas) {
 		canvas.SetFontFace(fontFace)
 	}
 }
 
+// ParseHexColor parses a colour in the form #rrggbb or #rrggbbaa. Colours without an alpha are opaque.
+func ParseHexColor(s string) (color.RGBA, error) {
+	hex := strings.TrimPrefix(s, "#")
+	if len(hex) != 6 && len(hex) != 8 {
+		return color.RGBA{}, fmt.Errorf("invalid colour %q: expected #rrggbb or #rrggbbaa", s)
+	}
+	if len(hex) == 6 {
+		hex += "ff"
+	}
+	v, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return color.RGBA{}, fmt.Errorf("invalid colour %q: expected #rrggbb or #rrggbbaa", s)
+	}
+	return color.RGBA{R: uint8(v >> 24), G: uint8(v >> 16), B: uint8(v >> 8), A: uint8(v)}, nil
+}
+
 func RandomColor() color.RGBA {
 	return color.RGBA{
 		R: uint8(rand.Intn(255)),
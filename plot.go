@@ -4,12 +4,11 @@ import (
 	"image/color"
 	"math"
 
-	"github.com/fogleman/gg"
 	"github.com/warmans/gochart/pkg/style"
 )
 
 type Plot interface {
-	Render(canvas *gg.Context, b BoundingBox) error
+	Render(canvas Renderer, b BoundingBox) error
 	ReplaceSeries(fn func(s Series) Series)
 	ReplaceYScale(fn func(s YScale) YScale)
 	YScale() YScale
@@ -40,12 +39,80 @@ func PlotStyleFn(fn func(v float64) style.Opts) PlotOpt {
 		if bars, ok := p.(*BarsPlot); ok {
 			bars.styleFn = fn
 		}
+		if bars, ok := p.(*HorizontalBarsPlot); ok {
+			bars.styleFn = fn
+		}
 		if lines, ok := p.(*LinesPlot); ok {
 			lines.styleFn = fn
 		}
 	}
 }
 
+// GapMode is how a plot treats missing (NaN) values.
+type GapMode int
+
+const (
+	// GapBreak leaves a gap where values are missing. Lines and areas are broken and points and bars are not drawn.
+	GapBreak GapMode = iota
+	// GapConnect joins lines and areas across missing values. Points and bars are still not drawn.
+	GapConnect
+	// GapInterpolate replaces missing values with a linear interpolation of the values either side so they are drawn
+	// by every plot. Missing values at the start or end of the series are left as gaps.
+	GapInterpolate
+)
+
+// PlotGaps sets how a LinesPlot, AreaPlot, PointsPlot, BarsPlot or HorizontalBarsPlot treats missing (NaN) values.
+// The default is GapBreak.
+func PlotGaps(mode GapMode) PlotOpt {
+	return func(p Plot) {
+		switch plot := p.(type) {
+		case *LinesPlot:
+			plot.gaps = mode
+		case *AreaPlot:
+			plot.gaps = mode
+		case *PointsPlot:
+			plot.gaps = mode
+		case *BarsPlot:
+			plot.gaps = mode
+		case *HorizontalBarsPlot:
+			plot.gaps = mode
+		}
+	}
+}
+
+// gapValues returns the Y values of the series with missing values filled in if the mode is GapInterpolate.
+func gapValues(s Series, mode GapMode) []float64 {
+	if mode != GapInterpolate {
+		return s.Ys()
+	}
+	return interpolateNaN(s.Ys(), func(i int) float64 {
+		return seriesXValue(s, i)
+	})
+}
+
+// GridZeroLineStyle sets the style of the line a YGrid or HorizontalGrid draws at zero when the scale includes
+// negative values.
+func GridZeroLineStyle(opt ...style.Opt) PlotOpt {
+	return func(p Plot) {
+		if grid, ok := p.(*YGrid); ok {
+			grid.zeroStyles.SetStyle(opt...)
+		}
+		if grid, ok := p.(*HorizontalGrid); ok {
+			grid.zeroStyles.SetStyle(opt...)
+		}
+	}
+}
+
+// GridMinorLineStyle sets the style of the lines a YGrid draws at minor ticks.
+func GridMinorLineStyle(opt ...style.Opt) PlotOpt {
+	return func(p Plot) {
+		if grid, ok := p.(*YGrid); ok {
+			grid.minorStyles.SetStyle(opt...)
+		}
+	}
+}
+
+// PointSizeFn sets the size of each point from its Y value and a label of its X value taken from the series.
 func PointSizeFn(fn func(v float64, x Label) float64) PlotOpt {
 	return func(p Plot) {
 		if points, ok := p.(*PointsPlot); ok {
@@ -54,6 +121,21 @@ func PointSizeFn(fn func(v float64, x Label) float64) PlotOpt {
 	}
 }
 
+// BarPadding sets the space between the bars of a BarsPlot or HorizontalBarsPlot. GroupPlots overrides this.
+func BarPadding(padding float64) PlotOpt {
+	return func(p Plot) {
+		if b, ok := p.(interface{ group() *barGroup }); ok {
+			b.group().outerPadding = padding
+		}
+	}
+}
+
+// defaultPlotStyles gives a plot the first colour of the default theme's palette. Layouts give each of their plots
+// the next colour of the palette in order.
+func defaultPlotStyles() Styles {
+	return newThemeStyles(style.Opts{style.Color(DefaultTheme.Palette.Color(0))})
+}
+
 func StackPlots(vs ...Plot) ([]Plot, YScale) {
 	stacked := make([]Plot, len(vs))
 
@@ -61,12 +143,17 @@ func StackPlots(vs ...Plot) ([]Plot, YScale) {
 	var lastSeries Series
 	var maxYScaleTicks int
 	for k := range vs {
+		below := lastSeries
 		vs[k].ReplaceSeries(func(s Series) Series {
 			originalSeries = append(originalSeries, s)
 			merged := s.AdditiveMerge(lastSeries)
 			lastSeries = merged
 			return merged
 		})
+		// stacked areas are only filled down to the top of the area below.
+		if area, ok := vs[k].(*AreaPlot); ok && below != nil {
+			area.baseline = below
+		}
 		stacked[(len(vs)-1)-k] = vs[k]
 		if numTicks := vs[k].YScale().NumTicks(); numTicks > maxYScaleTicks {
 			maxYScaleTicks = numTicks
@@ -81,7 +168,82 @@ func StackPlots(vs ...Plot) ([]Plot, YScale) {
 	return stacked, stackedScale
 }
 
+// GroupPlots draws the bars of each plot side by side within each tick rather than on top of each other. The inner
+// padding is the space between bars in a group and the outer padding is the space between groups. Plots other than
+// BarsPlot and HorizontalBarsPlot are not moved. All the plots are changed to use the returned scale which covers every series.
+//
+// The returned scale is the same type as the scale of the first plot: a StdYScale (including range scales) with the
+// most ticks of any of the plots, or a LogYScale with the same options. A FixedYScale or any other type of scale is
+// used as it is so it must already cover every series.
+func GroupPlots(innerPadding, outerPadding float64, vs ...Plot) ([]Plot, YScale) {
+	var allSeries []Series
+	var maxYScaleTicks int
+	for k := range vs {
+		vs[k].ReplaceSeries(func(s Series) Series {
+			allSeries = append(allSeries, s)
+			return s
+		})
+		if numTicks := vs[k].YScale().NumTicks(); numTicks > maxYScaleTicks {
+			maxYScaleTicks = numTicks
+		}
+	}
+
+	var groups []*barGroup
+	for _, p := range vs {
+		if b, ok := p.(interface{ group() *barGroup }); ok {
+			groups = append(groups, b.group())
+		}
+	}
+	for k, g := range groups {
+		*g = barGroup{groupIndex: k, groupSize: len(groups), innerPadding: innerPadding, outerPadding: outerPadding}
+	}
+
+	var groupedScale YScale = NewYScale(maxYScaleTicks, allSeries...)
+	if len(vs) > 0 {
+		switch scale := vs[0].YScale().(type) {
+		case *StdYScale:
+			groupedScale = &StdYScale{d: allSeries, numTicks: maxYScaleTicks, fitRange: scale.fitRange}
+		case *LogYScale:
+			groupedScale = &LogYScale{d: allSeries, base: scale.base, minorTicks: scale.minorTicks}
+		case nil:
+		default:
+			groupedScale = scale
+		}
+	}
+	for k := range vs {
+		vs[k].ReplaceYScale(func(s YScale) YScale {
+			return groupedScale
+		})
+	}
+	return vs, groupedScale
+}
+
+func newBarGroup() barGroup {
+	return barGroup{groupSize: 1, outerPadding: defaultMargin}
+}
+
+// barGroup is the position of a plot's bars within a group of bars drawn side by side, see GroupPlots.
+type barGroup struct {
+	groupIndex   int
+	groupSize    int
+	innerPadding float64
+	outerPadding float64
+}
+
+func (g *barGroup) group() *barGroup {
+	return g
+}
+
+// barSize returns the size of each bar in the group and the offset of the plot's bar from the start of the space
+// allocated to a tick.
+func (g *barGroup) barSize(tickSize float64) (float64, float64) {
+	groupSize := tickSize - g.outerPadding
+	barSize := math.Max((groupSize-g.innerPadding*float64(g.groupSize-1))/float64(g.groupSize), 1)
+	return barSize, g.outerPadding/2 + float64(g.groupIndex)*(barSize+g.innerPadding)
+}
+
 func NewCompositePlot(plots ...Plot) *CompositePlot {
+	DefaultTheme.colorPlots(plots)
 	return &CompositePlot{plots: plots}
 }
 
@@ -89,7 +251,7 @@ type CompositePlot struct {
 	plots []Plot
 }
 
-func (c *CompositePlot) Render(canvas *gg.Context, container BoundingBox) error {
+func (c *CompositePlot) Render(canvas Renderer, container BoundingBox) error {
 	for _, p := range c.plots {
 		if err := p.Render(canvas, container); err != nil {
 			return err
@@ -100,7 +262,7 @@ func (c *CompositePlot) Render(canvas *gg.Context, container BoundingBox) error
 
 func NewPointsPlot(yScale YScale, xScale XScale, s Series, opts ...PlotOpt) Plot {
 	p := &PointsPlot{
-		Styles:    NewStyles(style.DefaultPlotOpts...),
+		Styles:    defaultPlotStyles(),
 		s:         s,
 		pointSize: 2,
 		yScale:    yScale,
@@ -120,28 +282,33 @@ type PointsPlot struct {
 	xScale    XScale
 	styleFn   func(v float64) style.Opts
 	sizeFn    func(v float64, x Label) float64
+	gaps      GapMode
 }
 
-func (c *PointsPlot) Render(canvas *gg.Context, b BoundingBox) error {
+func (c *PointsPlot) Render(canvas Renderer, b BoundingBox) error {
 
 	canvas.Push()
 	defer canvas.Pop()
 
 	c.styleOpts.Apply(canvas)
 
-	tickWidth := b.W/float64(len(c.s.Ys())) - defaultMargin
+	points := gapValues(c.s, c.gaps)
+	tickWidth := b.W/float64(len(points)) - defaultMargin
 
-	for i, v := range c.s.Ys() {
+	for i, v := range points {
+		if math.IsNaN(v) {
+			continue
+		}
 		canvas.Push()
 		if c.styleFn != nil {
 			c.styleFn(v).Apply(canvas)
 		}
 		size := c.pointSize
 		if c.sizeFn != nil {
-			size = c.sizeFn(v, c.xScale.Labels()[i])
+			size = c.sizeFn(v, Label{Value: c.s.X(i), Tick: i, At: seriesXValue(c.s, i)})
 		}
 		canvas.DrawCircle(
-			c.xScale.Position(i, b)+tickWidth/2,
+			xPosition(c.xScale, c.s, i, tickWidth, b),
 			c.yScale.Position(v, b),
 			size,
 		)
@@ -171,7 +338,7 @@ func PlotWithStyles(p Plot, opts ...style.Opt) Plot {
 
 func NewLinesPlot(yScale YScale, xScale XScale, s Series, opts ...PlotOpt) Plot {
 	p := &LinesPlot{
-		Styles: NewStyles(style.DefaultPlotOpts...),
+		Styles: defaultPlotStyles(),
 		yScale: yScale,
 		xScale: xScale,
 		s:      s,
@@ -188,25 +355,31 @@ type LinesPlot struct {
 	xScale  XScale
 	s       Series
 	styleFn func(v float64) style.Opts
+	gaps    GapMode
 }
 
-func (c *LinesPlot) Render(canvas *gg.Context, b BoundingBox) error {
+func (c *LinesPlot) Render(canvas Renderer, b BoundingBox) error {
 
 	canvas.Push()
 	defer canvas.Pop()
 
 	c.styleOpts.Apply(canvas)
 
-	points := c.s.Ys()
+	points := gapValues(c.s, c.gaps)
 
 	tickWidth := b.W/float64(len(points)) - defaultMargin
 
-	previousPoint := 0.0
+	// line is drawn from each point to the previous one, so the first one cannot be drawn
+	previous := -1
 	for i, v := range points {
-
-		// line is drawn from each point to the previous one, so the first one cannot be drawn
-		if i < 1 {
-			previousPoint = v
+		if math.IsNaN(v) {
+			if c.gaps == GapBreak {
+				previous = -1
+			}
+			continue
+		}
+		if previous < 0 {
+			previous = i
 			continue
 		}
 		canvas.Push()
@@ -214,15 +387,15 @@ func (c *LinesPlot) Render(canvas *gg.Context, b BoundingBox) error {
 			c.styleFn(v).Apply(canvas)
 		}
 		canvas.DrawLine(
-			c.xScale.Position(i, b)+tickWidth/2,
+			xPosition(c.xScale, c.s, i, tickWidth, b),
 			c.yScale.Position(v, b),
-			c.xScale.Position(i-1, b)+tickWidth/2,
-			c.yScale.Position(previousPoint, b),
+			xPosition(c.xScale, c.s, previous, tickWidth, b),
+			c.yScale.Position(points[previous], b),
 		)
 		canvas.Stroke()
 		canvas.Pop()
 
-		previousPoint = v
+		previous = i
 	}
 
 	return nil
@@ -242,10 +415,11 @@ func (c *LinesPlot) YScale() YScale {
 
 func NewBarsPlot(yScale YScale, xScale XScale, s Series, opts ...PlotOpt) *BarsPlot {
 	p := &BarsPlot{
-		Styles: NewStyles(style.DefaultPlotOpts...),
-		yScale: yScale,
-		xScale: xScale,
-		s:      s,
+		Styles:   defaultPlotStyles(),
+		yScale:   yScale,
+		xScale:   xScale,
+		s:        s,
+		barGroup: newBarGroup(),
 	}
 	for _, o := range opts {
 		o(p)
@@ -259,27 +433,41 @@ type BarsPlot struct {
 	xScale  XScale
 	s       Series
 	styleFn func(v float64) style.Opts
+	gaps    GapMode
+	barGroup
 }
 
-func (c *BarsPlot) Render(canvas *gg.Context, b BoundingBox) error {
+func (c *BarsPlot) Render(canvas Renderer, b BoundingBox) error {
 
 	canvas.Push()
 	defer canvas.Pop()
 
 	c.styleOpts.Apply(canvas)
 
-	maxBarWidth := math.Max(b.W/float64(c.xScale.NumTicks())-defaultMargin, 1)
+	maxBarWidth, groupOffset := c.barSize(b.W / float64(c.xScale.NumTicks()))
+	if _, ok := c.xScale.(ContinuousXScale); !ok {
+		// groups are centered on the space a bar with the default padding would use so they line up with the axis
+		// ticks. Continuous scales (e.g. histogram bins) already place each tick at the start of its bar.
+		groupOffset -= defaultMargin / 2
+	}
 
-	for i, v := range c.s.Ys() {
+	// bars grow up or down from zero, or from the edge of the scale if it does not include zero.
+	min, max := fitYMinMax(c.yScale, b.H)
+	baseline := c.yScale.Position(math.Min(math.Max(0, min), max), b)
+
+	for i, v := range gapValues(c.s, c.gaps) {
+		if math.IsNaN(v) {
+			continue
+		}
 		canvas.Push()
 		if c.styleFn != nil {
 			c.styleFn(v).Apply(canvas)
 		}
 		canvas.DrawRectangle(
-			c.xScale.Position(i, b),
-			b.RelY(b.H),
+			c.xScale.Position(i, b)+groupOffset,
+			baseline,
 			maxBarWidth,
-			0-(c.yScale.Position(0, b)-c.yScale.Position(v, b)),
+			c.yScale.Position(v, b)-baseline,
 		)
 		canvas.Fill()
 		canvas.Stroke()
@@ -301,10 +489,257 @@ func (c *BarsPlot) YScale() YScale {
 	return c.yScale
 }
 
+// NewHorizontalBarsPlot creates a plot of bars growing to the right from zero with one bar per category of the
+// category scale. The value scale is drawn along the X axis e.g. with NewHorizontalValueAxis. The plot can be
+// stacked with StackPlots or grouped with GroupPlots in the same way as BarsPlot.
+func NewHorizontalBarsPlot(valueScale YScale, categoryScale *CategoryYScale, s Series, opts ...PlotOpt) *HorizontalBarsPlot {
+	p := &HorizontalBarsPlot{
+		Styles:        defaultPlotStyles(),
+		valueScale:    valueScale,
+		categoryScale: categoryScale,
+		s:             s,
+		barGroup:      newBarGroup(),
+	}
+	for _, o := range opts {
+		o(p)
+	}
+	return p
+}
+
+type HorizontalBarsPlot struct {
+	Styles
+	valueScale    YScale
+	categoryScale *CategoryYScale
+	s             Series
+	styleFn       func(v float64) style.Opts
+	gaps          GapMode
+	barGroup
+}
+
+func (c *HorizontalBarsPlot) Render(canvas Renderer, b BoundingBox) error {
+
+	canvas.Push()
+	defer canvas.Pop()
+
+	c.styleOpts.Apply(canvas)
+
+	barHeight, groupOffset := c.barSize(c.categoryScale.BandHeight(b))
+
+	min, max := fitYMinMax(c.valueScale, b.W)
+	baseline := horizontalPosition(c.valueScale, math.Min(math.Max(0, min), max), b)
+
+	for i, v := range gapValues(c.s, c.gaps) {
+		if i >= c.categoryScale.NumTicks() {
+			break
+		}
+		if math.IsNaN(v) {
+			continue
+		}
+		canvas.Push()
+		if c.styleFn != nil {
+			c.styleFn(v).Apply(canvas)
+		}
+		canvas.DrawRectangle(
+			baseline,
+			c.categoryScale.Position(i, b)+groupOffset,
+			horizontalPosition(c.valueScale, v, b)-baseline,
+			barHeight,
+		)
+		canvas.Fill()
+		canvas.Pop()
+	}
+
+	return nil
+}
+
+func (c *HorizontalBarsPlot) ReplaceSeries(fn func(s Series) Series) {
+	c.s = fn(c.s)
+}
+
+func (c *HorizontalBarsPlot) ReplaceYScale(fn func(s YScale) YScale) {
+	c.valueScale = fn(c.YScale())
+}
+
+// YScale returns the value scale which is drawn horizontally.
+func (c *HorizontalBarsPlot) YScale() YScale {
+	return c.valueScale
+}
+
+// AreaBaseline fills an AreaPlot down to the given series rather than zero.
+func AreaBaseline(s Series) PlotOpt {
+	return func(p Plot) {
+		if area, ok := p.(*AreaPlot); ok {
+			area.baseline = s
+		}
+	}
+}
+
+// NewAreaPlot creates a plot that fills the space between the series and a baseline. The baseline is zero unless
+// set with AreaBaseline. When stacked with StackPlots each area is filled down to the area below it. Fills can be
+// made semi-transparent with a colour that has an alpha value or use style.VerticalGradient.
+func NewAreaPlot(yScale YScale, xScale XScale, s Series, opts ...PlotOpt) Plot {
+	p := &AreaPlot{
+		Styles: defaultPlotStyles(),
+		yScale: yScale,
+		xScale: xScale,
+		s:      s,
+	}
+	for _, o := range opts {
+		o(p)
+	}
+	return p
+}
+
+type AreaPlot struct {
+	Styles
+	yScale   YScale
+	xScale   XScale
+	s        Series
+	baseline Series
+	gaps     GapMode
+}
+
+func (c *AreaPlot) Render(canvas Renderer, b BoundingBox) error {
+
+	points := gapValues(c.s, c.gaps)
+	if len(points) == 0 {
+		return nil
+	}
+
+	canvas.Push()
+	defer canvas.Pop()
+
+	gradient := &gradientCanvas{Renderer: canvas}
+	c.styleOpts.Apply(gradient)
+
+	tickWidth := b.W/float64(len(points)) - defaultMargin
+
+	min, max := fitYMinMax(c.yScale, b.H)
+	zero := c.yScale.Position(math.Min(math.Max(0, min), max), b)
+
+	// each run of values without gaps is filled separately. Connecting gaps joins all the values into one run.
+	var runs [][]int
+	var run []int
+	for i, v := range points {
+		if math.IsNaN(v) {
+			if c.gaps == GapBreak && len(run) > 0 {
+				runs = append(runs, run)
+				run = nil
+			}
+			continue
+		}
+		run = append(run, i)
+	}
+	if len(run) > 0 {
+		runs = append(runs, run)
+	}
+
+	// the outline of each run goes left to right along the series then back along the baseline.
+	var outline []point
+	for _, run := range runs {
+		start := len(outline)
+		for _, i := range run {
+			outline = append(outline, point{X: xPosition(c.xScale, c.s, i, tickWidth, b), Y: c.yScale.Position(points[i], b)})
+		}
+		for k := len(run) - 1; k >= 0; k-- {
+			i := run[k]
+			if c.baseline == nil || math.IsNaN(c.baseline.Y(i)) {
+				outline = append(outline, point{X: xPosition(c.xScale, c.s, i, tickWidth, b), Y: zero})
+				continue
+			}
+			outline = append(outline, point{
+				X: xPosition(c.xScale, c.baseline, i, tickWidth, b),
+				Y: c.yScale.Position(c.baseline.Y(i), b),
+			})
+		}
+
+		canvas.NewSubPath()
+		for _, p := range outline[start:] {
+			canvas.LineTo(p.X, p.Y)
+		}
+		canvas.ClosePath()
+	}
+	if len(outline) == 0 {
+		canvas.ClearPath()
+		return nil
+	}
+
+	if !gradient.enabled {
+		canvas.Fill()
+		return nil
+	}
+
+	// gradients are drawn as bands of colour clipped to the area so they work with any renderer.
+	top, bottom := math.Inf(1), math.Inf(-1)
+	for _, p := range outline {
+		top = math.Min(top, p.Y)
+		bottom = math.Max(bottom, p.Y)
+	}
+	canvas.Clip()
+	defer canvas.ResetClip()
+
+	// bands are aligned to whole pixels so anti-aliasing does not leave visible seams between them.
+	top, bottom = math.Floor(top), math.Ceil(bottom)
+	bandHeight := math.Ceil((bottom - top) / 256)
+	for y := top; y < bottom; y += bandHeight {
+		canvas.SetColor(gradient.colorAt((y + bandHeight/2 - top) / (bottom - top)))
+		canvas.DrawRectangle(b.RelX(0), y, b.W, bandHeight)
+		canvas.Fill()
+	}
+
+	return nil
+}
+
+func (c *AreaPlot) ReplaceSeries(fn func(s Series) Series) {
+	c.s = fn(c.s)
+}
+
+func (c *AreaPlot) ReplaceYScale(fn func(s YScale) YScale) {
+	c.yScale = fn(c.YScale())
+}
+
+func (c *AreaPlot) YScale() YScale {
+	return c.yScale
+}
+
+// gradientCanvas records a gradient set by style.VerticalGradient so it can be drawn by the plot.
+type gradientCanvas struct {
+	Renderer
+	enabled bool
+	top     color.NRGBA
+	bottom  color.NRGBA
+}
+
+func (g *gradientCanvas) SetGradient(top, bottom color.Color) {
+	g.enabled = true
+	g.top = nrgba(top)
+	g.bottom = nrgba(bottom)
+}
+
+func (g *gradientCanvas) SetColor(c color.Color) {
+	g.enabled = false
+	g.Renderer.SetColor(c)
+}
+
+// colorAt returns the colour of the gradient at t between 0 (top) and 1 (bottom).
+func (g *gradientCanvas) colorAt(t float64) color.NRGBA {
+	mix := func(a, b uint8) uint8 {
+		return uint8(math.Round(float64(a) + (float64(b)-float64(a))*t))
+	}
+	return color.NRGBA{
+		R: mix(g.top.R, g.bottom.R),
+		G: mix(g.top.G, g.bottom.G),
+		B: mix(g.top.B, g.bottom.B),
+		A: mix(g.top.A, g.bottom.A),
+	}
+}
+
 func NewYGrid(yScale YScale, opts ...PlotOpt) Plot {
 	p := &YGrid{
-		Styles: NewStyles(style.Color(color.RGBA{A: 64})),
-		yScale: yScale,
+		Styles:      newThemeStyles(DefaultTheme.GridStyles),
+		minorStyles: newThemeStyles(DefaultTheme.GridMinorStyles),
+		zeroStyles:  newThemeStyles(DefaultTheme.GridZeroStyles),
+		yScale:      yScale,
 	}
 	for _, o := range opts {
 		o(p)
@@ -314,21 +749,25 @@ func NewYGrid(yScale YScale, opts ...PlotOpt) Plot {
 
 type YGrid struct {
 	Styles
-	yScale YScale
+	minorStyles Styles
+	zeroStyles  Styles
+	yScale      YScale
 }
 
-func (g *YGrid) Render(canvas *gg.Context, b BoundingBox) error {
+func (g *YGrid) Render(canvas Renderer, b BoundingBox) error {
 	canvas.Push()
 	defer canvas.Pop()
 
 	g.styleOpts.Apply(canvas)
 
-	_, max := g.yScale.MinMax()
-
-	for i := range g.yScale.Labels() {
+	var minor []Label
+	for _, label := range fitYLabels(g.yScale, b.H) {
+		if label.Minor {
+			minor = append(minor, label)
+			continue
+		}
 
-		spacing := max / float64(g.yScale.NumTicks())
-		linePos := g.yScale.Position(spacing*float64(i), b)
+		linePos := g.yScale.Position(label.At, b)
 
 		canvas.DrawLine(
 			b.RelX(0),
@@ -340,6 +779,26 @@ func (g *YGrid) Render(canvas *gg.Context, b BoundingBox) error {
 
 	canvas.Stroke()
 
+	if len(minor) > 0 {
+		canvas.Push()
+		g.minorStyles.styleOpts.Apply(canvas)
+		for _, label := range minor {
+			linePos := g.yScale.Position(label.At, b)
+			canvas.DrawLine(b.RelX(0), linePos, b.RelX(b.W), linePos)
+		}
+		canvas.Stroke()
+		canvas.Pop()
+	}
+
+	// the zero line is highlighted when there are negative values below it.
+	if min, max := fitYMinMax(g.yScale, b.H); min < 0 && max >= 0 {
+		g.zeroStyles.styleOpts.Apply(canvas)
+
+		linePos := g.yScale.Position(0, b)
+		canvas.DrawLine(b.RelX(0), linePos, b.RelX(b.W), linePos)
+		canvas.Stroke()
+	}
+
 	return nil
 }
 
@@ -354,3 +813,63 @@ func (g *YGrid) ReplaceYScale(fn func(s YScale) YScale) {
 func (g *YGrid) YScale() YScale {
 	return g.yScale
 }
+
+// NewHorizontalGrid creates vertical grid lines at each label of a value scale drawn horizontally, see
+// HorizontalBarsPlot.
+func NewHorizontalGrid(valueScale YScale, opts ...PlotOpt) Plot {
+	p := &HorizontalGrid{
+		Styles:     newThemeStyles(DefaultTheme.GridStyles),
+		zeroStyles: newThemeStyles(DefaultTheme.GridZeroStyles),
+		valueScale: valueScale,
+	}
+	for _, o := range opts {
+		o(p)
+	}
+	return p
+}
+
+type HorizontalGrid struct {
+	Styles
+	zeroStyles Styles
+	valueScale YScale
+}
+
+func (g *HorizontalGrid) Render(canvas Renderer, b BoundingBox) error {
+	canvas.Push()
+	defer canvas.Pop()
+
+	g.styleOpts.Apply(canvas)
+
+	for _, label := range horizontalLabels(canvas, g.valueScale, b.W) {
+		if label.Minor {
+			continue
+		}
+		linePos := horizontalPosition(g.valueScale, label.At, b)
+		canvas.DrawLine(linePos, b.RelY(0), linePos, b.RelY(b.H))
+	}
+
+	canvas.Stroke()
+
+	// the zero line is highlighted when there are negative values to the left of it.
+	if min, max := fitYMinMax(g.valueScale, b.W); min < 0 && max >= 0 {
+		g.zeroStyles.styleOpts.Apply(canvas)
+
+		linePos := horizontalPosition(g.valueScale, 0, b)
+		canvas.DrawLine(linePos, b.RelY(0), linePos, b.RelY(b.H))
+		canvas.Stroke()
+	}
+
+	return nil
+}
+
+func (g *HorizontalGrid) ReplaceSeries(fn func(s Series) Series) {
+	// no op - grid doesn't need a series
+}
+
+func (g *HorizontalGrid) ReplaceYScale(fn func(s YScale) YScale) {
+	g.valueScale = fn(g.YScale())
+}
+
+func (g *HorizontalGrid) YScale() YScale {
+	return g.valueScale
+}
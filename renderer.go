@@ -0,0 +1,131 @@
+package gochart
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/fogleman/gg"
+	"github.com/warmans/gochart/pkg/style"
+)
+
+// Renderer is the drawing surface used by every plot, axis and layout.
+//
+// The method set deliberately mirrors the subset of *gg.Context the library needs, so a gg context can be
+// passed anywhere a Renderer is expected to produce raster output. Other backends must follow the same
+// semantics: shapes are added to the current path until it is filled or stroked, Push/Pop save and restore
+// the drawing state (colour, line style, font and transform) but not the current path or clip, and text
+// is drawn with its baseline at the given Y position.
+type Renderer interface {
+	style.Canvas
+
+	Width() int
+	Height() int
+
+	Push()
+	Pop()
+	RotateAbout(angle, x, y float64)
+
+	MoveTo(x, y float64)
+	LineTo(x, y float64)
+	ClosePath()
+	NewSubPath()
+	ClearPath()
+	DrawLine(x1, y1, x2, y2 float64)
+	DrawRectangle(x, y, w, h float64)
+	DrawCircle(x, y, r float64)
+	DrawArc(x, y, r, angle1, angle2 float64)
+
+	Fill()
+	FillPreserve()
+	Stroke()
+	StrokePreserve()
+	Clip()
+	ResetClip()
+
+	FontHeight() float64
+	MeasureString(s string) (w, h float64)
+	DrawString(s string, x, y float64)
+	DrawStringAnchored(s string, x, y, ax, ay float64)
+}
+
+// gg is the default raster backend.
+var _ Renderer = (*gg.Context)(nil)
+
+type textAlign int
+
+const (
+	alignLeft textAlign = iota
+	alignCenter
+	alignRight
+)
+
+// drawStringWrapped word-wraps the string to the given width and draws it anchored at the given point in the
+// same way as gg.Context.DrawStringWrapped.
+func drawStringWrapped(canvas Renderer, s string, x, y, ax, ay, width, lineSpacing float64, align textAlign) {
+	lines := wordWrap(canvas, s, width)
+
+	h := float64(len(lines)) * canvas.FontHeight() * lineSpacing
+	h -= (lineSpacing - 1) * canvas.FontHeight()
+
+	x -= ax * width
+	y -= ay * h
+	switch align {
+	case alignLeft:
+		ax = 0
+	case alignCenter:
+		ax = 0.5
+		x += width / 2
+	case alignRight:
+		ax = 1
+		x += width
+	}
+	for _, line := range lines {
+		canvas.DrawStringAnchored(line, x, y, ax, 1)
+		y += canvas.FontHeight() * lineSpacing
+	}
+}
+
+func wordWrap(canvas Renderer, s string, width float64) []string {
+	var result []string
+	for _, line := range strings.Split(s, "\n") {
+		fields := splitOnSpace(line)
+		if len(fields)%2 == 1 {
+			fields = append(fields, "")
+		}
+		x := ""
+		for i := 0; i < len(fields); i += 2 {
+			if w, _ := canvas.MeasureString(x + fields[i]); w > width {
+				if x == "" {
+					result = append(result, fields[i])
+					continue
+				}
+				result = append(result, x)
+				x = ""
+			}
+			x += fields[i] + fields[i+1]
+		}
+		if x != "" {
+			result = append(result, x)
+		}
+	}
+	for i, line := range result {
+		result[i] = strings.TrimSpace(line)
+	}
+	return result
+}
+
+// splitOnSpace splits the string into alternating words and whitespace.
+func splitOnSpace(x string) []string {
+	var result []string
+	pi := 0
+	ps := false
+	for i, c := range x {
+		s := unicode.IsSpace(c)
+		if s != ps && i > 0 {
+			result = append(result, x[pi:i])
+			pi = i
+		}
+		ps = s
+	}
+	return append(result, x[pi:])
+}
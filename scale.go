@@ -1,10 +1,19 @@
 package gochart
 
-import "fmt"
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+)
 
 type Label struct {
 	Value string
 	Tick  int
+	// At is the value of the label on a continuous scale.
+	At float64
+	// Minor labels only mark a tick and have no text.
+	Minor bool
 }
 
 type XScale interface {
@@ -21,6 +30,70 @@ type YScale interface {
 	Position(v float64, b BoundingBox) float64
 }
 
+// ContinuousXScale is an XScale that positions points by their X value (see ContinuousSeries) rather than their
+// index. Labels must set the At field to the value they represent.
+type ContinuousXScale interface {
+	XScale
+	MinMax() (float64, float64)
+	PositionValue(v float64, b BoundingBox) float64
+}
+
+// FittedYScale is implemented by Y scales that choose their labels based on the height available to draw them.
+// FitMinMax is the range of the scale when drawn at the given height, which may differ from MinMax as more ticks can
+// extend the range to different round numbers.
+type FittedYScale interface {
+	YScale
+	FitLabels(height float64) []Label
+	FitMinMax(height float64) (float64, float64)
+}
+
+// FittedXScale is implemented by X scales that choose their labels based on the space available to draw them.
+type FittedXScale interface {
+	XScale
+	FitLabels(canvas Renderer, width float64) []Label
+}
+
+// xPosition returns the X position of the i-th point of the series. Continuous scales place the point by its X value,
+// otherwise it is centered within the space allocated to its tick.
+func xPosition(xScale XScale, s Series, i int, tickWidth float64, b BoundingBox) float64 {
+	if cs, ok := xScale.(ContinuousXScale); ok {
+		return cs.PositionValue(seriesXValue(s, i), b)
+	}
+	return xScale.Position(i, b) + tickWidth/2
+}
+
+// tickPosition returns the X position of the given label for drawing an axis.
+func tickPosition(xScale XScale, label Label, tickWidth float64, b BoundingBox) float64 {
+	if cs, ok := xScale.(ContinuousXScale); ok {
+		return cs.PositionValue(label.At, b)
+	}
+	return xScale.Position(label.Tick, b) + tickWidth/2
+}
+
+// fitLabels returns the labels of the scale that fit into the given width.
+func fitLabels(canvas Renderer, xScale XScale, width float64) []Label {
+	if fs, ok := xScale.(FittedXScale); ok {
+		return fs.FitLabels(canvas, width)
+	}
+	return reduceNumLabelsToFitSpace(canvas, xScale.Labels(), width)
+}
+
+// fitYLabels returns the labels of the scale to draw within the given height.
+func fitYLabels(yScale YScale, height float64) []Label {
+	if fs, ok := yScale.(FittedYScale); ok {
+		return fs.FitLabels(height)
+	}
+	return yScale.Labels()
+}
+
+// fitYMinMax returns the range of the scale when drawn at the given height.
+func fitYMinMax(yScale YScale, height float64) (float64, float64) {
+	if fs, ok := yScale.(FittedYScale); ok {
+		return fs.FitMinMax(height)
+	}
+	return yScale.MinMax()
+}
+
 func NewXScaleFromLabels(labels []string) *LabelXScale {
 	return &LabelXScale{labels: labels}
 }
@@ -59,6 +132,104 @@ func (l *LabelXScale) Offset() float64 {
 	panic("implement me")
 }
 
+// NewCategoryYScale creates a scale that places the labels top to bottom in equal sized bands down the vertical
+// axis. It is used for charts with categories on the Y axis and values on the X axis e.g. HorizontalBarsPlot.
+func NewCategoryYScale(labels []string) *CategoryYScale {
+	return &CategoryYScale{labels: labels}
+}
+
+type CategoryYScale struct {
+	labels []string
+}
+
+func (s *CategoryYScale) NumTicks() int {
+	return len(s.labels)
+}
+
+func (s *CategoryYScale) Labels() []Label {
+	labels := make([]Label, len(s.labels))
+	for k, v := range s.labels {
+		labels[k] = Label{Tick: k, Value: v}
+	}
+	return labels
+}
+
+// Position returns the top of the band of the i-th category.
+func (s *CategoryYScale) Position(i int, b BoundingBox) float64 {
+	return b.RelY(s.BandHeight(b) * float64(i))
+}
+
+func (s *CategoryYScale) BandHeight(b BoundingBox) float64 {
+	return b.H / math.Max(float64(s.NumTicks()), 1)
+}
+
+// ValueScale returns the scale as a YScale where the value of each category is its index. Position places a value
+// at the middle of the category's band so plots and grids that expect a YScale can be drawn against the categories.
+func (s *CategoryYScale) ValueScale() YScale {
+	return &categoryValueScale{s}
+}
+
+type categoryValueScale struct {
+	*CategoryYScale
+}
+
+func (s *categoryValueScale) Labels() []Label {
+	labels := s.CategoryYScale.Labels()
+	for k := range labels {
+		labels[k].At = float64(k)
+	}
+	return labels
+}
+
+func (s *categoryValueScale) MinMax() (float64, float64) {
+	return 0, math.Max(float64(s.NumTicks()-1), 0)
+}
+
+func (s *categoryValueScale) Position(v float64, b BoundingBox) float64 {
+	return b.RelY(s.BandHeight(b) * (v + 0.5))
+}
+
+// horizontalValueScale is a value scale drawn along the X axis as a ContinuousXScale.
+type horizontalValueScale struct {
+	scale YScale
+}
+
+// NumTicks is the number of spaces between the labels as for LinearXScale.
+func (s *horizontalValueScale) NumTicks() int {
+	return len(s.scale.Labels()) - 1
+}
+
+func (s *horizontalValueScale) Labels() []Label {
+	return s.scale.Labels()
+}
+
+func (s *horizontalValueScale) Position(i int, b BoundingBox) float64 {
+	labels := s.Labels()
+	if i < 0 || i >= len(labels) {
+		return b.RelX(b.W)
+	}
+	return s.PositionValue(labels[i].At, b)
+}
+
+func (s *horizontalValueScale) PositionValue(v float64, b BoundingBox) float64 {
+	return horizontalPosition(s.scale, v, b)
+}
+
+func (s *horizontalValueScale) MinMax() (float64, float64) {
+	return s.scale.MinMax()
+}
+
+func (s *horizontalValueScale) Offset() float64 {
+	return 0
+}
+
+// horizontalPosition maps a value of the scale onto the X axis of the box rather than the Y axis. The box is
+// transposed so the scale sees the width as its height, then the result is flipped so values increase to the right.
+func horizontalPosition(scale YScale, v float64, b BoundingBox) float64 {
+	transposed := BoundingBox{X: b.Y, Y: b.X, W: b.H, H: b.W}
+	return b.RelX(b.W) - (scale.Position(v, transposed) - b.RelX(0))
+}
+
 func NewXScale(series Series, offset float64) *StdXScale {
 	return &StdXScale{series: series, offset: offset}
 }
@@ -78,7 +249,7 @@ func (s *StdXScale) NumTicks() int {
 func (s *StdXScale) Labels() []Label {
 	labels := make([]Label, s.NumTicks())
 	for i := 0; i < s.NumTicks(); i++ {
-		labels[i] = Label{s.series.X(i), i}
+		labels[i] = Label{Value: s.series.X(i), Tick: i}
 	}
 	return labels
 }
@@ -101,6 +272,71 @@ func (s *StdXScale) Offset() float64 {
 	return s.offset
 }
 
+// NewLinearXScale creates a continuous scale spanning the X values of all the given series with evenly spaced ticks.
+func NewLinearXScale(numTicks int, series ...Series) *LinearXScale {
+	return &LinearXScale{d: series, numTicks: numTicks}
+}
+
+type LinearXScale struct {
+	d        []Series
+	numTicks int
+}
+
+func (s *LinearXScale) NumTicks() int {
+	return s.numTicks
+}
+
+func (s *LinearXScale) Labels() []Label {
+	min, max := s.MinMax()
+	labels := make([]Label, s.NumTicks()+1)
+	for i := 0; i <= s.NumTicks(); i++ {
+		v := min + ((max-min)/float64(s.NumTicks()))*float64(i)
+		labels[i] = Label{Value: formatFloat(v), Tick: i, At: v}
+	}
+	return labels
+}
+
+func (s *LinearXScale) Position(i int, b BoundingBox) float64 {
+	return b.MapX(0, float64(s.NumTicks()), float64(i))
+}
+
+func (s *LinearXScale) PositionValue(v float64, b BoundingBox) float64 {
+	min, max := s.MinMax()
+	return b.MapX(min, max, v)
+}
+
+func (s *LinearXScale) Offset() float64 {
+	return 0
+}
+
+func (s *LinearXScale) MinMax() (float64, float64) {
+	min, max := math.Inf(1), math.Inf(-1)
+	for _, series := range s.d {
+		for i := range series.Ys() {
+			v := seriesXValue(series, i)
+			if math.IsNaN(v) {
+				continue
+			}
+			min = math.Min(min, v)
+			max = math.Max(max, v)
+		}
+	}
+	if math.IsInf(min, 0) {
+		return 0, 1
+	}
+	if min == max {
+		return min - 1, max + 1
+	}
+	return min, max
+}
+
+// AutoTicks can be given as the number of ticks for a Y scale to choose round tick values (steps of 1, 2 or 5 times
+// a power of 10) spaced to suit the height of the chart.
+const AutoTicks = 0
+
+// the minimum space between ticks in pixels when using AutoTicks.
+const autoTickSpacing = 40
+
 func NewYScale(numTicks int, series ...Series) *StdYScale {
 	return &StdYScale{
 		d:        series,
@@ -108,35 +344,60 @@ func NewYScale(numTicks int, series ...Series) *StdYScale {
 	}
 }
 
+// NewRangeYScale creates a StdYScale that fits the range of the data rather than always including zero e.g. for
+// prices that vary far from zero.
+func NewRangeYScale(numTicks int, series ...Series) *StdYScale {
+	return &StdYScale{
+		d:        series,
+		numTicks: numTicks,
+		fitRange: true,
+	}
+}
+
 type StdYScale struct {
 	d        []Series
 	numTicks int
+	fitRange bool
 }
 
+// MinMax is the range of the scale with the default number of ticks. See FitMinMax.
 func (r *StdYScale) MinMax() (float64, float64) {
-	return floatsRange(allYData(r.d))
+	return r.FitMinMax(0)
+}
+
+func (r *StdYScale) FitMinMax(height float64) (float64, float64) {
+	min, max := r.dataRange()
+	min, max, _ = yTickRange(min, max, r.NumTicks(), height)
+	return min, max
 }
 
 func (r *StdYScale) NumTicks() int {
-	return r.numTicks //todo: should scale based on the canvas size
+	return r.numTicks
 }
 
 func (r *StdYScale) Labels() []Label {
-	labels := make([]Label, r.NumTicks()+1)
-	_, max := r.MinMax()
-	for i := 0; i <= r.NumTicks(); i++ {
-		labels[i] = Label{fmt.Sprintf("%0.2f", (max/float64(r.NumTicks()))*float64(i)), i}
-	}
-	return labels
+	return r.FitLabels(0)
+}
+
+func (r *StdYScale) FitLabels(height float64) []Label {
+	min, max := r.dataRange()
+	return yLabels(min, max, r.NumTicks(), height)
 }
 
 func (r *StdYScale) Position(v float64, b BoundingBox) float64 {
-	min, max := r.MinMax()
+	min, max := r.FitMinMax(b.H)
 	return b.MapY(min, max, v)
 }
 
+func (r *StdYScale) dataRange() (float64, float64) {
+	if r.fitRange {
+		return floatsBounds(allYData(r.d))
+	}
+	return floatsRange(allYData(r.d))
+}
+
 func NewStackedYScale(numTicks int, series ...Series) YScale {
-	return &StackedYScale{d: series, numTicks: 10}
+	return &StackedYScale{d: series, numTicks: numTicks}
 }
 
 type StackedYScale struct {
@@ -149,25 +410,38 @@ func (s *StackedYScale) NumTicks() int {
 }
 
 func (s *StackedYScale) Labels() []Label {
-	labels := make([]Label, s.NumTicks()+1)
-	_, max := s.MinMax()
-	for i := 0; i <= s.NumTicks(); i++ {
-		labels[i] = Label{fmt.Sprintf("%0.2f", (max/float64(s.NumTicks()))*float64(i)), i}
-	}
-	return labels
+	return s.FitLabels(0)
+}
+
+func (s *StackedYScale) FitLabels(height float64) []Label {
+	min, max := s.dataRange()
+	return yLabels(min, max, s.NumTicks(), height)
 }
 
+// MinMax is the range of the scale with the default number of ticks. See FitMinMax.
 func (s *StackedYScale) MinMax() (float64, float64) {
-	min, _ := floatsRange(allYData(s.d))
-	_, max := floatRange(additiveFloatMerge(allYData(s.d)))
+	return s.FitMinMax(0)
+}
+
+func (s *StackedYScale) FitMinMax(height float64) (float64, float64) {
+	min, max := s.dataRange()
+	min, max, _ = yTickRange(min, max, s.NumTicks(), height)
 	return min, max
 }
 
 func (s *StackedYScale) Position(v float64, b BoundingBox) float64 {
-	min, max := s.MinMax()
+	min, max := s.FitMinMax(b.H)
 	return b.MapY(min, max, v)
 }
 
+// dataRange is the range of both the individual and the merged series as negative values may make a stack smaller
+// than its parts.
+func (s *StackedYScale) dataRange() (float64, float64) {
+	min, max := floatsRange(allYData(s.d))
+	mergedMin, mergedMax := floatRange(additiveFloatMerge(allYData(s.d)))
+	return math.Min(min, mergedMin), math.Max(max, mergedMax)
+}
+
 func NewFixedYScale(numTicks int, maxValue float64) *FixedYScale {
 	return &FixedYScale{
 		numTicks: numTicks,
@@ -180,23 +454,465 @@ type FixedYScale struct {
 	fixedMax float64
 }
 
+// MinMax is the range of the scale with the default number of ticks. See FitMinMax.
 func (r *FixedYScale) MinMax() (float64, float64) {
-	return 0, r.fixedMax
+	return r.FitMinMax(0)
+}
+
+func (r *FixedYScale) FitMinMax(height float64) (float64, float64) {
+	min, max, _ := yTickRange(0, r.fixedMax, r.NumTicks(), height)
+	return min, max
 }
 
 func (r *FixedYScale) NumTicks() int {
-	return r.numTicks //todo: should scale based on the canvas size
+	return r.numTicks
 }
 
 func (r *FixedYScale) Labels() []Label {
-	labels := make([]Label, r.NumTicks()+1)
-	for i := 0; i <= r.NumTicks(); i++ {
-		labels[i] = Label{fmt.Sprintf("%0.2f", (r.fixedMax/float64(r.NumTicks()))*float64(i)), i}
-	}
-	return labels
+	return r.FitLabels(0)
+}
+
+func (r *FixedYScale) FitLabels(height float64) []Label {
+	return yLabels(0, r.fixedMax, r.NumTicks(), height)
 }
 
 func (r *FixedYScale) Position(v float64, b BoundingBox) float64 {
-	min, max := r.MinMax()
+	min, max := r.FitMinMax(b.H)
 	return b.MapY(min, max, v)
 }
+
+type LogYScaleOpt func(s *LogYScale)
+
+// LogBase sets the base of the scale. The default is 10.
+func LogBase(base float64) LogYScaleOpt {
+	return func(s *LogYScale) {
+		s.base = base
+	}
+}
+
+// LogMinorTicks adds unlabelled ticks at each integer multiple of a power of the base e.g. 20, 30 ... 90 between
+// 10 and 100. It has no effect if the base is not a whole number.
+func LogMinorTicks() LogYScaleOpt {
+	return func(s *LogYScale) {
+		s.minorTicks = true
+	}
+}
+
+// NewLogYScale creates a logarithmic scale spanning whole powers of the base around the positive values of the
+// given series. Zero and negative values cannot be shown on a log scale so they are placed at the bottom of the
+// scale. Use NewLogYScaleWithOpts to change the base or add minor ticks.
+func NewLogYScale(series ...Series) *LogYScale {
+	return NewLogYScaleWithOpts(nil, series...)
+}
+
+// NewLogYScaleWithOpts creates a logarithmic scale as NewLogYScale with the given options.
+func NewLogYScaleWithOpts(opts []LogYScaleOpt, series ...Series) *LogYScale {
+	s := &LogYScale{d: series, base: 10}
+	for _, o := range opts {
+		o(s)
+	}
+	if s.base <= 1 {
+		s.base = 10
+	}
+	return s
+}
+
+type LogYScale struct {
+	d          []Series
+	base       float64
+	minorTicks bool
+}
+
+// NumTicks is the number of powers of the base covered by the scale.
+func (s *LogYScale) NumTicks() int {
+	min, max := s.exponents()
+	return max - min
+}
+
+func (s *LogYScale) Labels() []Label {
+	min, max := s.exponents()
+	labels := []Label{}
+	for e := min; e <= max; e++ {
+		at := math.Pow(s.base, float64(e))
+		labels = append(labels, Label{Value: logLabel(at), Tick: len(labels), At: at})
+		if !s.minorTicks || e == max || s.base != math.Trunc(s.base) {
+			continue
+		}
+		for m := 2.0; m < s.base; m++ {
+			labels = append(labels, Label{Tick: len(labels), At: at * m, Minor: true})
+		}
+	}
+	return labels
+}
+
+func (s *LogYScale) MinMax() (float64, float64) {
+	min, max := s.exponents()
+	return math.Pow(s.base, float64(min)), math.Pow(s.base, float64(max))
+}
+
+func (s *LogYScale) Position(v float64, b BoundingBox) float64 {
+	min, max := s.exponents()
+	if v <= 0 {
+		return b.RelY(b.H)
+	}
+	return b.MapY(float64(min), float64(max), s.log(v))
+}
+
+// exponents finds the powers of the base either side of the positive values in the series.
+func (s *LogYScale) exponents() (int, int) {
+	min, max := math.Inf(1), math.Inf(-1)
+	for _, v := range allYData(s.d) {
+		for _, f := range v {
+			if f > 0 {
+				min = math.Min(min, f)
+				max = math.Max(max, f)
+			}
+		}
+	}
+	if math.IsInf(min, 0) {
+		return 0, 1
+	}
+	// the small tolerance stops rounding errors in the log from adding an extra power e.g. log10(1000) = 2.9999999999999996
+	minExp := int(math.Floor(s.log(min) + 1e-9))
+	maxExp := int(math.Ceil(s.log(max) - 1e-9))
+	if maxExp <= minExp {
+		maxExp = minExp + 1
+	}
+	return minExp, maxExp
+}
+
+func (s *LogYScale) log(v float64) float64 {
+	return math.Log(v) / math.Log(s.base)
+}
+
+// logLabel formats a power of the base. Fractions are formatted with significant figures as a fixed number of
+// decimal places would round them to zero.
+func logLabel(v float64) string {
+	if v >= 1 {
+		return formatFloat(v)
+	}
+	return strconv.FormatFloat(v, 'g', 3, 64)
+}
+
+// yTickRange divides the range into ticks. With AutoTicks the range is extended to round numbers with as many ticks
+// as fit into the height (or a default number of ticks if the height is unknown). Otherwise the range is divided
+// into numTicks evenly spaced ticks and, if it spans both negative and positive values, extended so zero falls
+// exactly on a tick. The extended range is returned along with the step between ticks.
+func yTickRange(min, max float64, numTicks int, height float64) (float64, float64, float64) {
+	min, max = tickableRange(min, max)
+	if numTicks == AutoTicks {
+		maxTicks := 10
+		if height > 0 {
+			maxTicks = int(math.Max(height/autoTickSpacing, 2))
+		}
+		return niceTickRange(min, max, maxTicks)
+	}
+	if numTicks < 1 {
+		numTicks = 1
+	}
+	if min >= 0 || max <= 0 || numTicks == 1 {
+		return min, max, (max - min) / float64(numTicks)
+	}
+	// find the split of ticks above and below zero that needs the smallest step.
+	step := math.Inf(1)
+	below := 1
+	for k := 1; k < numTicks; k++ {
+		if s := math.Max(-min/float64(k), max/float64(numTicks-k)); s < step {
+			step = s
+			below = k
+		}
+	}
+	return -step * float64(below), step * float64(numTicks-below), step
+}
+
+// maxTickValue is the largest value a Y scale divides into ticks. Larger values would overflow when the size of the
+// range is calculated.
+const maxTickValue = 1e300
+
+// tickableRange makes a range that can be divided into ticks. NaN is replaced by zero, values beyond maxTickValue
+// (including infinity) are clamped to it and an empty range is widened.
+func tickableRange(min, max float64) (float64, float64) {
+	clamp := func(v float64) float64 {
+		if math.IsNaN(v) {
+			return 0
+		}
+		return math.Max(-maxTickValue, math.Min(maxTickValue, v))
+	}
+	min, max = clamp(min), clamp(max)
+	if max <= min {
+		// all the values are the same (or there are none) so there is no range to divide. Adding 1 to a large
+		// value would not change it so the range is widened relative to the value.
+		max = min + math.Max(1, math.Abs(min)*1e-6)
+	}
+	return min, max
+}
+
+// niceTickRange extends the range to multiples of a round step with at most maxTicks ticks.
+func niceTickRange(min, max float64, maxTicks int) (float64, float64, float64) {
+	min, max = tickableRange(min, max)
+	step := niceNumber((max-min)/float64(maxTicks), true)
+	// each attempt at least doubles the step so a few attempts always find one unless the range cannot be divided.
+	for attempt := 0; attempt < 20; attempt++ {
+		if !(step > 0) || math.IsInf(step, 0) {
+			break
+		}
+		// the tolerance stops rounding errors adding a tick e.g. 0.30000000000000004 / 0.1
+		niceMin := math.Floor(min/step+1e-9) * step
+		niceMax := math.Ceil(max/step-1e-9) * step
+		if math.Round((niceMax-niceMin)/step) <= float64(maxTicks) {
+			return niceMin, niceMax, step
+		}
+		// rounding the bounds outwards needed an extra tick so try the next larger step.
+		step = niceNumber(step*1.5, true)
+	}
+	// the range is divided evenly without rounding.
+	return min, max, (max - min) / float64(maxTicks)
+}
+
+// niceNumber finds a number close to v that is 1, 2 or 5 times a power of 10. If roundUp is set the result is never
+// smaller than v.
+func niceNumber(v float64, roundUp bool) float64 {
+	exp := math.Floor(math.Log10(v))
+	fraction := v / math.Pow(10, exp)
+	var nice float64
+	switch {
+	case roundUp && fraction <= 1:
+		nice = 1
+	case roundUp && fraction <= 2:
+		nice = 2
+	case roundUp && fraction <= 5:
+		nice = 5
+	case roundUp:
+		nice = 10
+	case fraction < 1.5:
+		nice = 1
+	case fraction < 3:
+		nice = 2
+	case fraction < 7:
+		nice = 5
+	default:
+		nice = 10
+	}
+	return nice * math.Pow(10, exp)
+}
+
+// yLabels creates the labels for a Y scale covering the given data range. Each label's At field is the value it
+// represents.
+func yLabels(min, max float64, numTicks int, height float64) []Label {
+	min, max, step := yTickRange(min, max, numTicks, height)
+	format := "%0.2f"
+	if numTicks == AutoTicks {
+		// show as many decimal places as the step has.
+		format = fmt.Sprintf("%%0.%df", int(math.Max(0, -math.Floor(math.Log10(step)+1e-9))))
+	}
+	if math.Max(math.Abs(min), math.Abs(max)) >= 1e15 {
+		// fixed decimal places would print every digit of very large values.
+		format = "%0.3g"
+	}
+	numLabels := int(math.Round((max-min)/step)) + 1
+	labels := make([]Label, numLabels)
+	for i := 0; i < numLabels; i++ {
+		at := min + step*float64(i)
+		labels[i] = Label{Value: fmt.Sprintf(format, at), Tick: i, At: at}
+	}
+	return labels
+}
+
+// timeInterval is a calendar aware step between ticks on a time scale.
+type timeInterval struct {
+	duration time.Duration
+	months   int
+	format   string
+}
+
+// truncate returns the first tick on or before the given time.
+func (i timeInterval) truncate(t time.Time) time.Time {
+	switch {
+	case i.months >= 12:
+		year := t.Year() - t.Year()%(i.months/12)
+		return time.Date(year, time.January, 1, 0, 0, 0, 0, t.Location())
+	case i.months > 0:
+		month := int(t.Month()) - (int(t.Month())-1)%i.months
+		return time.Date(t.Year(), time.Month(month), 1, 0, 0, 0, 0, t.Location())
+	case i.duration == time.Hour*24*7:
+		// weeks start on a monday.
+		daysSinceMonday := (int(t.Weekday()) + 6) % 7
+		return time.Date(t.Year(), t.Month(), t.Day()-daysSinceMonday, 0, 0, 0, 0, t.Location())
+	case i.duration >= time.Hour*24:
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	default:
+		// align to the interval within the day so e.g. 6 hour ticks fall on 00:00, 06:00...
+		day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+		return day.Add(t.Sub(day).Truncate(i.duration))
+	}
+}
+
+// approxSeconds is the average length of the interval.
+func (i timeInterval) approxSeconds() float64 {
+	if i.months > 0 {
+		return float64(i.months) * 30.44 * 24 * 60 * 60
+	}
+	return i.duration.Seconds()
+}
+
+func (i timeInterval) next(t time.Time) time.Time {
+	switch {
+	case i.months > 0:
+		return t.AddDate(0, i.months, 0)
+	case i.duration >= time.Hour*24:
+		return t.AddDate(0, 0, int(i.duration/(time.Hour*24)))
+	default:
+		return t.Add(i.duration)
+	}
+}
+
+// timeIntervals are the possible steps between ticks on a time scale from finest to coarsest.
+var timeIntervals = []timeInterval{
+	{duration: time.Second, format: "15:04:05"},
+	{duration: time.Second * 5, format: "15:04:05"},
+	{duration: time.Second * 15, format: "15:04:05"},
+	{duration: time.Second * 30, format: "15:04:05"},
+	{duration: time.Minute, format: "15:04"},
+	{duration: time.Minute * 5, format: "15:04"},
+	{duration: time.Minute * 15, format: "15:04"},
+	{duration: time.Minute * 30, format: "15:04"},
+	{duration: time.Hour, format: "15:04"},
+	{duration: time.Hour * 3, format: "15:04"},
+	{duration: time.Hour * 6, format: "Jan 02 15:04"},
+	{duration: time.Hour * 12, format: "Jan 02 15:04"},
+	{duration: time.Hour * 24, format: "Jan 02"},
+	{duration: time.Hour * 24 * 2, format: "Jan 02"},
+	{duration: time.Hour * 24 * 7, format: "Jan 02"},
+	{months: 1, format: "Jan 2006"},
+	{months: 3, format: "Jan 2006"},
+	{months: 6, format: "Jan 2006"},
+	{months: 12, format: "2006"},
+	{months: 12 * 2, format: "2006"},
+	{months: 12 * 5, format: "2006"},
+	{months: 12 * 10, format: "2006"},
+	{months: 12 * 25, format: "2006"},
+	{months: 12 * 50, format: "2006"},
+	{months: 12 * 100, format: "2006"},
+}
+
+// NewTimeXScale creates a continuous scale that positions the points of a TimeSeries proportionally to their time so
+// gaps in the data are visible. Ticks are placed on calendar boundaries (minutes, hours, days, months, years) using
+// the finest interval that results in no more than maxTicks ticks. When used with an XStdAxis the interval is instead
+// chosen so the labels fit the width of the axis.
+func NewTimeXScale(maxTicks int, series ...Series) *TimeXScale {
+	s := &TimeXScale{d: series, maxTicks: maxTicks, loc: time.UTC}
+	for _, ser := range series {
+		if ohlc, ok := ser.(*OHLCSeries); ok {
+			ser = ohlc.TimeSeries
+		}
+		if ts, ok := ser.(*TimeSeries); ok && len(ts.x) > 0 {
+			s.loc = ts.x[0].Location()
+			break
+		}
+	}
+	return s
+}
+
+type TimeXScale struct {
+	d        []Series
+	maxTicks int
+	loc      *time.Location
+}
+
+func (s *TimeXScale) NumTicks() int {
+	return len(s.Labels())
+}
+
+func (s *TimeXScale) Labels() []Label {
+	var labels []Label
+	for _, interval := range timeIntervals {
+		if s.estimateNumTicks(interval) > float64(s.maxTicks) {
+			continue
+		}
+		labels = s.labels(interval)
+		if len(labels) <= s.maxTicks {
+			break
+		}
+	}
+	return labels
+}
+
+// FitLabels returns the labels for the finest interval where every label fits in the given width.
+func (s *TimeXScale) FitLabels(canvas Renderer, width float64) []Label {
+	var labels []Label
+	for _, interval := range timeIntervals {
+		// even a single pixel per label would not fit so there is no need to generate them.
+		if s.estimateNumTicks(interval) > width {
+			continue
+		}
+		labels = s.labels(interval)
+		if totalLabelsWidth(canvas, labels, defaultMargin*2) <= width {
+			break
+		}
+	}
+	return labels
+}
+
+func (s *TimeXScale) Position(i int, b BoundingBox) float64 {
+	labels := s.Labels()
+	if i < 0 || i >= len(labels) {
+		return b.RelX(b.W)
+	}
+	return s.PositionValue(labels[i].At, b)
+}
+
+func (s *TimeXScale) PositionValue(v float64, b BoundingBox) float64 {
+	min, max := s.MinMax()
+	return b.MapX(min, max, v)
+}
+
+func (s *TimeXScale) Offset() float64 {
+	return 0
+}
+
+// MinMax returns the range of the scale as seconds since the unix epoch.
+func (s *TimeXScale) MinMax() (float64, float64) {
+	min, max := math.Inf(1), math.Inf(-1)
+	for _, series := range s.d {
+		for i := range series.Ys() {
+			v := seriesXValue(series, i)
+			if math.IsNaN(v) {
+				continue
+			}
+			min = math.Min(min, v)
+			max = math.Max(max, v)
+		}
+	}
+	if math.IsInf(min, 0) {
+		return 0, 1
+	}
+	if min == max {
+		return min - 1, max + 1
+	}
+	return min, max
+}
+
+func (s *TimeXScale) estimateNumTicks(interval timeInterval) float64 {
+	min, max := s.MinMax()
+	return (max - min) / interval.approxSeconds()
+}
+
+func (s *TimeXScale) labels(interval timeInterval) []Label {
+	min, max := s.MinMax()
+
+	// allow for the loss of precision converting times to float seconds.
+	const tolerance = 0.001
+
+	labels := []Label{}
+	for t := interval.truncate(unixSecondsToTime(min).In(s.loc)); ; t = interval.next(t) {
+		at := timeToUnixSeconds(t)
+		if at > max+tolerance {
+			break
+		}
+		if at < min-tolerance {
+			continue
+		}
+		labels = append(labels, Label{Value: t.Format(interval.format), Tick: len(labels), At: at})
+	}
+	return labels
+}
@@ -0,0 +1,53 @@
+package gochart
+
+import (
+	"math"
+	"testing"
+)
+
+func TestNiceTickRangeExtremeValues(t *testing.T) {
+	tests := []struct {
+		name     string
+		min, max float64
+	}{
+		{name: "huge range", min: -1e308, max: 1e308},
+		{name: "equal huge values", min: 1e308, max: 1e308},
+		{name: "equal huge negative values", min: -1e308, max: -1e308},
+		{name: "infinite", min: math.Inf(-1), max: math.Inf(1)},
+		{name: "NaN", min: math.NaN(), max: math.NaN()},
+		{name: "zero", min: 0, max: 0},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			min, max, step := niceTickRange(test.min, test.max, 10)
+			if math.IsNaN(min) || math.IsInf(min, 0) || math.IsNaN(max) || math.IsInf(max, 0) {
+				t.Fatalf("expected a finite range, got %v to %v", min, max)
+			}
+			if !(step > 0) || math.IsInf(step, 0) {
+				t.Fatalf("expected a positive finite step, got %v", step)
+			}
+			if max <= min {
+				t.Fatalf("expected max %v to be greater than min %v", max, min)
+			}
+		})
+	}
+}
+
+func TestYScaleLabelsExtremeValues(t *testing.T) {
+	series := []Series{
+		NewYSeries([]float64{1e308, -1e308}),
+		NewYSeries([]float64{1e308, 1e308}),
+		NewYSeries([]float64{math.Inf(1), 1}),
+	}
+	for _, s := range series {
+		for _, scale := range []YScale{NewYScale(AutoTicks, s), NewYScale(3, s), NewRangeYScale(AutoTicks, s), NewFixedYScale(3, 1e308)} {
+			labels := fitYLabels(scale, 1)
+			if len(labels) == 0 || len(labels) > 11 {
+				t.Fatalf("expected 1 to 11 labels, got %d", len(labels))
+			}
+			if pos := scale.Position(1, BoundingBox{W: 10, H: 10}); math.IsNaN(pos) {
+				t.Fatalf("expected a position, got NaN")
+			}
+		}
+	}
+}
@@ -2,6 +2,7 @@ package gochart
 
 import (
 	"fmt"
+	"math"
 	"time"
 )
 
@@ -21,6 +22,20 @@ type Series interface {
 	AdditiveMerge(add Series) Series
 }
 
+// ContinuousSeries is a series where the X values are points on a continuous scale rather than just labels.
+type ContinuousSeries interface {
+	Series
+	XValue(i int) float64
+}
+
+// seriesXValue returns the continuous X value of the point at i. Series that only have labels use the index instead.
+func seriesXValue(s Series, i int) float64 {
+	if cs, ok := s.(ContinuousSeries); ok {
+		return cs.XValue(i)
+	}
+	return float64(i)
+}
+
 type XYSeries struct {
 	x []string
 	y []float64
@@ -77,6 +92,66 @@ func (s *XYSeries) AdditiveMerge(add Series) Series {
 	return merged
 }
 
+func NewNumericSeries(x []float64, y []float64) Series {
+	return &NumericSeries{x: x, y: y}
+}
+
+// NumericSeries is a series of X/Y pairs where both values are numbers e.g. for scatter plots.
+type NumericSeries struct {
+	x []float64
+	y []float64
+}
+
+func (s *NumericSeries) X(i int) string {
+	if i < len(s.x) {
+		return formatFloat(s.x[i])
+	}
+	return ""
+}
+
+func (s *NumericSeries) XValue(i int) float64 {
+	if i < len(s.x) {
+		return s.x[i]
+	}
+	return 0.0
+}
+
+func (s *NumericSeries) Y(i int) float64 {
+	if i < len(s.y) {
+		return s.y[i]
+	}
+	return 0.0
+}
+
+func (s *NumericSeries) Ys() []float64 {
+	return s.y
+}
+
+func (s *NumericSeries) Xs() []string {
+	xs := make([]string, len(s.x))
+	for k := range s.x {
+		xs[k] = s.X(k)
+	}
+	return xs
+}
+
+func (s *NumericSeries) AdditiveMerge(add Series) Series {
+	merged := &NumericSeries{
+		x: make([]float64, len(s.Ys())),
+		y: make([]float64, len(s.Ys())),
+	}
+	for k := range s.Ys() {
+		merged.x[k] = s.XValue(k)
+		merged.y[k] = s.Y(k)
+	}
+	if add != nil {
+		for k := range merged.Ys() {
+			merged.y[k] += add.Y(k)
+		}
+	}
+	return merged
+}
+
 type TimeSeriesOpt func(t *TimeSeries)
 
 func TimeFormat(formater func(t time.Time) string) TimeSeriesOpt {
@@ -107,6 +182,14 @@ func (t *TimeSeries) X(i int) string {
 	return ""
 }
 
+// XValue returns the time of the point at i as seconds since the unix epoch.
+func (t *TimeSeries) XValue(i int) float64 {
+	if i < len(t.x) {
+		return timeToUnixSeconds(t.x[i])
+	}
+	return 0.0
+}
+
 func (t *TimeSeries) Y(i int) float64 {
 	if i < len(t.y) {
 		return t.y[i]
@@ -158,3 +241,115 @@ func (t *TimeSeries) formatTime(ts time.Time) string {
 	}
 	return t.timeFormatter(ts)
 }
+
+// OHLC is the open, high, low and close values of a period.
+type OHLC struct {
+	Open  float64
+	High  float64
+	Low   float64
+	Close float64
+}
+
+// RangeSeries is a series where each point spans a range of Y values rather than a single value. Y scales include
+// the whole range of every point.
+type RangeSeries interface {
+	Series
+	Lows() []float64
+	Highs() []float64
+}
+
+// NewOHLCSeries creates a time series of open, high, low and close values e.g. for a CandlestickPlot. The Y value of
+// each point is its close so the series can also be drawn by the other plots.
+func NewOHLCSeries(x []time.Time, values []OHLC, opts ...TimeSeriesOpt) *OHLCSeries {
+	closes := make([]float64, len(values))
+	for k, v := range values {
+		closes[k] = v.Close
+	}
+	ts := &TimeSeries{x: x, y: closes, seriesDuration: TimeSeriesDuration(x)}
+	for _, opt := range opts {
+		opt(ts)
+	}
+	return &OHLCSeries{TimeSeries: ts, values: values}
+}
+
+type OHLCSeries struct {
+	*TimeSeries
+	values []OHLC
+}
+
+// OHLC returns the values of the point at i.
+func (s *OHLCSeries) OHLC(i int) OHLC {
+	if i < len(s.values) {
+		return s.values[i]
+	}
+	return OHLC{}
+}
+
+func (s *OHLCSeries) Lows() []float64 {
+	lows := make([]float64, len(s.values))
+	for k, v := range s.values {
+		lows[k] = v.Low
+	}
+	return lows
+}
+
+func (s *OHLCSeries) Highs() []float64 {
+	highs := make([]float64, len(s.values))
+	for k, v := range s.values {
+		highs[k] = v.High
+	}
+	return highs
+}
+
+// NewMatrixSeries creates a grid of values with labelled columns along the X axis and rows along the Y axis e.g. for
+// a HeatmapPlot. values[i][j] is the value of the j-th row of the i-th column.
+func NewMatrixSeries(x []string, y []string, values [][]float64) *MatrixSeries {
+	return &MatrixSeries{columns: NewXYSeries(x, columnTotals(values)), rows: y, values: values}
+}
+
+// NewTimeMatrixSeries creates a MatrixSeries where each column is a point in time e.g. a latency histogram per
+// scrape.
+func NewTimeMatrixSeries(x []time.Time, y []string, values [][]float64, opts ...TimeSeriesOpt) *MatrixSeries {
+	return &MatrixSeries{columns: NewTimeSeries(x, columnTotals(values), opts...), rows: y, values: values}
+}
+
+type MatrixSeries struct {
+	columns Series
+	rows    []string
+	values  [][]float64
+}
+
+// Columns returns a series with a point for each column so it can be used to create X scales and axes. The Y value
+// of each point is the total of the column.
+func (s *MatrixSeries) Columns() Series {
+	return s.columns
+}
+
+func (s *MatrixSeries) Rows() []string {
+	return s.rows
+}
+
+// Value returns the value of the given cell or NaN if it is missing.
+func (s *MatrixSeries) Value(col, row int) float64 {
+	if col < len(s.values) && row < len(s.values[col]) {
+		return s.values[col][row]
+	}
+	return math.NaN()
+}
+
+// Range returns the smallest and largest values of the cells.
+func (s *MatrixSeries) Range() (float64, float64) {
+	return floatsBounds(s.values)
+}
+
+func columnTotals(values [][]float64) []float64 {
+	totals := make([]float64, len(values))
+	for k, col := range values {
+		for _, v := range col {
+			if !math.IsNaN(v) {
+				totals[k] += v
+			}
+		}
+	}
+	return totals
+}
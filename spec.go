@@ -0,0 +1,997 @@
+package gochart
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"image/color"
+	"io"
+	"io/ioutil"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fogleman/gg"
+	"github.com/warmans/gochart/pkg/style"
+	"gopkg.in/yaml.v2"
+)
+
+// minSpecSize is the smallest width or height of a spec. Smaller charts have no room for the axes and plots.
+const minSpecSize = 100
+
+// MaxSpecValue is the largest magnitude of a value in a spec. Larger values would overflow the range of a scale.
+const MaxSpecValue = maxTickValue
+
+// maxSpecTicks is the most ticks a scale of a spec can have so a spec cannot make a chart with millions of labels.
+const maxSpecTicks = 100
+
+// the size of a chart rendered from a spec that does not set one.
+const (
+	DefaultSpecWidth  = 800
+	DefaultSpecHeight = 600
+)
+
+// Spec describes a chart as data so it can be written as JSON or YAML config rather than Go. A spec contains either a
+// single Chart or Rows of charts drawn in a 12 column grid. Series can be given inline by each plot or listed once in
+// Series and referenced by name.
+type Spec struct {
+	// Width and Height are the size in pixels. Each must be at least 100. The default is 800x600.
+	Width  int `json:"width,omitempty" yaml:"width,omitempty"`
+	Height int `json:"height,omitempty" yaml:"height,omitempty"`
+	// Theme is default or dark.
+	Theme string `json:"theme,omitempty" yaml:"theme,omitempty"`
+	// Palette is categorical, colorblind or high_contrast. The default is the palette of the theme.
+	Palette  string       `json:"palette,omitempty" yaml:"palette,omitempty"`
+	Title    string       `json:"title,omitempty" yaml:"title,omitempty"`
+	Subtitle string       `json:"subtitle,omitempty" yaml:"subtitle,omitempty"`
+	Footnote string       `json:"footnote,omitempty" yaml:"footnote,omitempty"`
+	Series   []SeriesSpec `json:"series,omitempty" yaml:"series,omitempty"`
+	Chart    *ChartSpec   `json:"chart,omitempty" yaml:"chart,omitempty"`
+	Rows     []RowSpec    `json:"rows,omitempty" yaml:"rows,omitempty"`
+}
+
+type RowSpec struct {
+	// Height is the share of the total height between 0 and 1. Rows without a height share the space left over.
+	Height  float64      `json:"height,omitempty" yaml:"height,omitempty"`
+	Columns []ColumnSpec `json:"columns" yaml:"columns"`
+}
+
+type ColumnSpec struct {
+	// Span is the number of the 12 grid columns used. Columns without a span share the columns left over.
+	Span int64 `json:"span,omitempty" yaml:"span,omitempty"`
+	// Chart is drawn in the column. Columns without a chart are left empty.
+	Chart *ChartSpec `json:"chart,omitempty" yaml:"chart,omitempty"`
+}
+
+type ChartSpec struct {
+	// Type is xy (the default) for plots drawn against X and Y scales, pie or donut.
+	Type     string     `json:"type,omitempty" yaml:"type,omitempty"`
+	Title    string     `json:"title,omitempty" yaml:"title,omitempty"`
+	Subtitle string     `json:"subtitle,omitempty" yaml:"subtitle,omitempty"`
+	Footnote string     `json:"footnote,omitempty" yaml:"footnote,omitempty"`
+	X        XScaleSpec `json:"x,omitempty" yaml:"x,omitempty"`
+	Y        YScaleSpec `json:"y,omitempty" yaml:"y,omitempty"`
+	// Grid draws horizontal grid lines at the Y ticks. The default is true.
+	Grid *bool `json:"grid,omitempty" yaml:"grid,omitempty"`
+	// Legend adds a legend entry for each plot (or slice of a pie) that has a name.
+	Legend bool `json:"legend,omitempty" yaml:"legend,omitempty"`
+	// Mode is overlay (the default), stack or group. Grouping only changes bars.
+	Mode string `json:"mode,omitempty" yaml:"mode,omitempty"`
+	// BarGap is the space in pixels between the bars of a group in group mode. The default is 2.
+	BarGap *float64 `json:"bar_gap,omitempty" yaml:"bar_gap,omitempty"`
+	// GroupGap is the space in pixels between groups of bars in group mode. The default is 10.
+	GroupGap *float64   `json:"group_gap,omitempty" yaml:"group_gap,omitempty"`
+	Pie      PieSpec    `json:"pie,omitempty" yaml:"pie,omitempty"`
+	Plots    []PlotSpec `json:"plots" yaml:"plots"`
+}
+
+type XScaleSpec struct {
+	// Type is category (the default), linear or time. Series X values are parsed as numbers for linear scales and
+	// as times for time scales.
+	Type string `json:"type,omitempty" yaml:"type,omitempty"`
+	// Ticks is the maximum number of ticks of linear and time scales. It must be at most 100.
+	Ticks int    `json:"ticks,omitempty" yaml:"ticks,omitempty"`
+	Title string `json:"title,omitempty" yaml:"title,omitempty"`
+}
+
+type YScaleSpec struct {
+	// Type is linear (the default), range to fit the data without including zero, log or fixed.
+	Type string `json:"type,omitempty" yaml:"type,omitempty"`
+	// Ticks is the number of ticks. The default picks round values to suit the height of the chart (see AutoTicks).
+	// It must be at most 100.
+	Ticks int `json:"ticks,omitempty" yaml:"ticks,omitempty"`
+	// Max is the top of a fixed scale.
+	Max float64 `json:"max,omitempty" yaml:"max,omitempty"`
+	// Base is the base of a log scale. The default is 10.
+	Base  float64 `json:"base,omitempty" yaml:"base,omitempty"`
+	Title string  `json:"title,omitempty" yaml:"title,omitempty"`
+}
+
+type PieSpec struct {
+	StartAngle float64 `json:"start_angle,omitempty" yaml:"start_angle,omitempty"`
+	// InnerRadius is the size of the hole as a proportion of the radius. Donuts default to 0.5.
+	InnerRadius *float64 `json:"inner_radius,omitempty" yaml:"inner_radius,omitempty"`
+	Padding     float64  `json:"padding,omitempty" yaml:"padding,omitempty"`
+	// Labels is outside (the default), inside or none.
+	Labels string `json:"labels,omitempty" yaml:"labels,omitempty"`
+}
+
+type PlotSpec struct {
+	// Type is lines, points, bars or area. Pie charts draw the series of their only plot and do not use the type.
+	Type string `json:"type,omitempty" yaml:"type,omitempty"`
+	// Name is used in the legend. The default is the name of the series.
+	Name string `json:"name,omitempty" yaml:"name,omitempty"`
+	// Series is the name of one of the series of the spec. Either Series or Data must be set.
+	Series string      `json:"series,omitempty" yaml:"series,omitempty"`
+	Data   *SeriesSpec `json:"data,omitempty" yaml:"data,omitempty"`
+	// Color is in the form #rrggbb or #rrggbbaa. The default is the next colour of the palette.
+	Color     string    `json:"color,omitempty" yaml:"color,omitempty"`
+	LineWidth float64   `json:"line_width,omitempty" yaml:"line_width,omitempty"`
+	Dash      []float64 `json:"dash,omitempty" yaml:"dash,omitempty"`
+	PointSize float64   `json:"point_size,omitempty" yaml:"point_size,omitempty"`
+	// Gaps is break (the default), connect or interpolate. See GapMode.
+	Gaps string `json:"gaps,omitempty" yaml:"gaps,omitempty"`
+}
+
+type SeriesSpec struct {
+	Name string `json:"name,omitempty" yaml:"name,omitempty"`
+	// X are labels, numbers or times depending on the X scale of the chart. Labels are optional on category scales.
+	X SpecLabels `json:"x,omitempty" yaml:"x,omitempty"`
+	// Y are the values. Nulls are missing values (NaN).
+	Y []*float64 `json:"y" yaml:"y"`
+	// TimeLayout is used to parse X values for time scales. The default is RFC 3339.
+	TimeLayout string `json:"time_layout,omitempty" yaml:"time_layout,omitempty"`
+}
+
+// SpecLabels are the X values of a series. In JSON they can be given as strings or numbers.
+type SpecLabels []string
+
+func (l *SpecLabels) UnmarshalJSON(data []byte) error {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	labels := make(SpecLabels, len(raw))
+	for k, r := range raw {
+		if len(r) > 0 && r[0] == '"' {
+			if err := json.Unmarshal(r, &labels[k]); err != nil {
+				return err
+			}
+			continue
+		}
+		labels[k] = string(r)
+	}
+	*l = labels
+	return nil
+}
+
+// SpecError is a problem with one field of a spec. Path is the location of the field using the JSON/YAML field
+// names e.g. rows[1].columns[0].chart.plots[2].series.
+type SpecError struct {
+	Path string
+	Msg  string
+}
+
+func (e SpecError) Error() string {
+	if e.Path == "" {
+		return e.Msg
+	}
+	return fmt.Sprintf("%s: %s", e.Path, e.Msg)
+}
+
+// SpecErrors are all the problems found in a spec.
+type SpecErrors []SpecError
+
+func (e SpecErrors) Error() string {
+	msgs := make([]string, len(e))
+	for k, err := range e {
+		msgs[k] = err.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// DecodeSpecJSON reads a spec from JSON. Unknown fields are an error. The spec is validated when it is built.
+func DecodeSpecJSON(r io.Reader) (*Spec, error) {
+	dec := json.NewDecoder(r)
+	dec.DisallowUnknownFields()
+	spec := &Spec{}
+	if err := dec.Decode(spec); err != nil {
+		var typeErr *json.UnmarshalTypeError
+		if errors.As(err, &typeErr) {
+			return nil, SpecErrors{{Path: specJSONPath(typeErr.Field), Msg: fmt.Sprintf("cannot use %s as %s", typeErr.Value, typeErr.Type)}}
+		}
+		return nil, fmt.Errorf("invalid spec: %w", err)
+	}
+	return spec, nil
+}
+
+// specJSONPath changes the path of a JSON decoding error to the form used by SpecError e.g. rows.0.columns to
+// rows[0].columns.
+func specJSONPath(field string) string {
+	parts := strings.Split(field, ".")
+	path := ""
+	for _, p := range parts {
+		if _, err := strconv.Atoi(p); err == nil {
+			path += "[" + p + "]"
+			continue
+		}
+		if path != "" {
+			path += "."
+		}
+		path += p
+	}
+	return path
+}
+
+// DecodeSpecYAML reads a spec from YAML. Unknown fields are an error. The spec is validated when it is built.
+func DecodeSpecYAML(r io.Reader) (*Spec, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	spec := &Spec{}
+	if err := yaml.UnmarshalStrict(data, spec); err != nil {
+		if errs := specYAMLErrors(data); len(errs) > 0 {
+			return nil, errs
+		}
+		return nil, fmt.Errorf("invalid spec: %w", err)
+	}
+	return spec, nil
+}
+
+// specYAMLErrors finds the paths of the fields of an invalid YAML spec. yaml.v2 only gives the line of each error so
+// unknown fields are found by comparing the document to the fields of Spec, then the document is decoded as JSON to
+// find values of the wrong type.
+func specYAMLErrors(data []byte) SpecErrors {
+	var doc yamlValue
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil
+	}
+	var errs SpecErrors
+	jsonDoc := yamlToJSON(doc, reflect.TypeOf(Spec{}), "", &errs)
+	if len(errs) > 0 {
+		return errs
+	}
+	jsonData, err := json.Marshal(jsonDoc)
+	if err != nil {
+		return nil
+	}
+	if _, err := DecodeSpecJSON(bytes.NewReader(jsonData)); err != nil {
+		errs, _ = err.(SpecErrors)
+	}
+	return errs
+}
+
+// yamlValue is a YAML value with the keys of mappings kept as strings. Decoding to interface{} would change keys such
+// as y and on to booleans.
+type yamlValue struct {
+	v interface{}
+}
+
+func (y *yamlValue) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var m map[string]yamlValue
+	if err := unmarshal(&m); err == nil {
+		y.v = m
+		return nil
+	}
+	var s []yamlValue
+	if err := unmarshal(&s); err == nil {
+		y.v = s
+		return nil
+	}
+	return unmarshal(&y.v)
+}
+
+// yamlToJSON converts a YAML value to the value JSON would have for the type t. Scalars are changed to strings for
+// string fields as YAML allows e.g. a name of 2024. Fields that t does not have are added to errs.
+func yamlToJSON(y yamlValue, t reflect.Type, path string, errs *SpecErrors) interface{} {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch v := y.v.(type) {
+	case map[string]yamlValue:
+		if t.Kind() != reflect.Struct {
+			return v
+		}
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		m := make(map[string]interface{}, len(v))
+		for _, k := range keys {
+			fieldPath := k
+			if path != "" {
+				fieldPath = path + "." + k
+			}
+			field, ok := jsonField(t, k)
+			if !ok {
+				*errs = append(*errs, SpecError{Path: fieldPath, Msg: "unknown field"})
+				continue
+			}
+			m[k] = yamlToJSON(v[k], field.Type, fieldPath, errs)
+		}
+		return m
+	case []yamlValue:
+		elem := t
+		if t.Kind() == reflect.Slice {
+			elem = t.Elem()
+		}
+		s := make([]interface{}, len(v))
+		for k, val := range v {
+			s[k] = yamlToJSON(val, elem, fmt.Sprintf("%s[%d]", path, k), errs)
+		}
+		return s
+	case nil, string:
+		return v
+	default:
+		if t.Kind() == reflect.String {
+			return fmt.Sprint(v)
+		}
+		return v
+	}
+}
+
+// jsonField finds the field of the struct with the given JSON name.
+func jsonField(t reflect.Type, name string) (reflect.StructField, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if strings.Split(f.Tag.Get("json"), ",")[0] == name {
+			return f, true
+		}
+	}
+	return reflect.StructField{}, false
+}
+
+// DecodeSpec reads a spec from JSON if it starts with a brace and from YAML otherwise.
+func DecodeSpec(r io.Reader) (*Spec, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("{")) {
+		return DecodeSpecJSON(bytes.NewReader(data))
+	}
+	return DecodeSpecYAML(bytes.NewReader(data))
+}
+
+// Size is the size of the canvas the spec should be rendered to.
+func (s *Spec) Size() (int, int) {
+	w, h := s.Width, s.Height
+	if w <= 0 {
+		w = DefaultSpecWidth
+	}
+	if h <= 0 {
+		h = DefaultSpecHeight
+	}
+	return w, h
+}
+
+// Validate checks the whole spec. The error is SpecErrors listing every problem found.
+func (s *Spec) Validate() error {
+	_, err := s.Build()
+	return err
+}
+
+// Build creates the layout described by the spec. If the spec is invalid the error is SpecErrors listing every
+// problem found.
+func (s *Spec) Build() (*GridLayout, error) {
+	b := &specBuilder{spec: s, named: map[string]int{}, cache: map[string]Series{}}
+	layout := b.build()
+	if len(b.errs) > 0 {
+		return nil, b.errs
+	}
+	return layout, nil
+}
+
+// Render builds the spec and draws it to the whole canvas. The canvas is first filled with the background of the
+// theme, or white if it is transparent.
+func (s *Spec) Render(canvas Renderer) error {
+	layout, err := s.Build()
+	if err != nil {
+		return err
+	}
+	return s.render(canvas, layout)
+}
+
+// render draws a layout built from the spec on its background.
+func (s *Spec) render(canvas Renderer, layout *GridLayout) error {
+	background := color.RGBA{R: 255, G: 255, B: 255, A: 255}
+	if theme, _ := s.theme(); theme.Background.A > 0 {
+		background = theme.Background
+	}
+	drawBackground(canvas, background, BoundingBox{W: float64(canvas.Width()), H: float64(canvas.Height())})
+	return layout.Render(canvas, BoundingBoxFromCanvas(canvas))
+}
+
+func (s *Spec) theme() (Theme, *SpecError) {
+	var theme Theme
+	switch s.Theme {
+	case "", "default":
+		theme = DefaultTheme
+	case "dark":
+		theme = DarkTheme
+	default:
+		return DefaultTheme, &SpecError{Path: "theme", Msg: fmt.Sprintf("unknown theme %q: expected default or dark", s.Theme)}
+	}
+	switch s.Palette {
+	case "":
+	case "categorical":
+		theme.Palette = style.Categorical
+	case "colorblind":
+		theme.Palette = style.ColorBlindSafe
+	case "high_contrast":
+		theme.Palette = style.HighContrast
+	default:
+		return theme, &SpecError{Path: "palette", Msg: fmt.Sprintf("unknown palette %q: expected categorical, colorblind or high_contrast", s.Palette)}
+	}
+	return theme, nil
+}
+
+// specBuilder creates the layout of a spec collecting every error rather than stopping at the first.
+type specBuilder struct {
+	spec  *Spec
+	named map[string]int
+	// cache holds the named series already converted for each type of X scale so errors are only reported once.
+	cache map[string]Series
+	errs  SpecErrors
+}
+
+func (b *specBuilder) errorf(path string, format string, args ...interface{}) {
+	b.errs = append(b.errs, SpecError{Path: path, Msg: fmt.Sprintf(format, args...)})
+}
+
+func (b *specBuilder) build() *GridLayout {
+	s := b.spec
+
+	if s.Width < 0 {
+		b.errorf("width", "must not be negative")
+	} else if s.Width > 0 && s.Width < minSpecSize {
+		b.errorf("width", "must be at least %d", minSpecSize)
+	}
+	if s.Height < 0 {
+		b.errorf("height", "must not be negative")
+	} else if s.Height > 0 && s.Height < minSpecSize {
+		b.errorf("height", "must be at least %d", minSpecSize)
+	}
+	theme, err := s.theme()
+	if err != nil {
+		b.errs = append(b.errs, *err)
+	}
+
+	for k, ser := range s.Series {
+		path := fmt.Sprintf("series[%d].name", k)
+		if ser.Name == "" {
+			b.errorf(path, "is required for series listed in the spec")
+			continue
+		}
+		if _, ok := b.named[ser.Name]; ok {
+			b.errorf(path, "duplicate series %q", ser.Name)
+			continue
+		}
+		b.named[ser.Name] = k
+	}
+
+	var rows []GridRow
+	switch {
+	case s.Chart != nil && len(s.Rows) > 0:
+		b.errorf("chart", "cannot be used with rows")
+	case s.Chart != nil:
+		rows = []GridRow{{HeightPercent: 1, Columns: []GridColumn{{ColSpan: 12, El: b.chart(s.Chart, "chart")}}}}
+	case len(s.Rows) > 0:
+		rows = b.rows(s.Rows)
+	default:
+		b.errorf("chart", "either chart or rows is required")
+	}
+
+	layout := New12ColGridLayout(rows...)
+	layout.SetTitle(s.Title)
+	layout.SetSubtitle(s.Subtitle)
+	layout.SetFootnote(s.Footnote)
+	theme.Apply(layout)
+	return layout
+}
+
+func (b *specBuilder) rows(specs []RowSpec) []GridRow {
+	// rows without a height share what is left over.
+	remaining, unset := 1.0, 0
+	for k, r := range specs {
+		if r.Height < 0 || r.Height > 1 {
+			b.errorf(fmt.Sprintf("rows[%d].height", k), "must be between 0 and 1")
+		}
+		remaining -= r.Height
+		if r.Height == 0 {
+			unset++
+		}
+	}
+	if remaining < -1e-9 {
+		b.errorf("rows", "heights add up to more than 1")
+	}
+
+	rows := make([]GridRow, len(specs))
+	for k, r := range specs {
+		path := fmt.Sprintf("rows[%d]", k)
+		rows[k].HeightPercent = r.Height
+		if r.Height == 0 {
+			rows[k].HeightPercent = math.Max(remaining, 0) / float64(unset)
+		}
+		if len(r.Columns) == 0 {
+			b.errorf(path+".columns", "at least one column is required")
+			continue
+		}
+
+		// columns without a span share what is left over.
+		remainingSpan, unsetSpan := int64(12), int64(0)
+		for j, c := range r.Columns {
+			if c.Span < 0 || c.Span > 12 {
+				b.errorf(fmt.Sprintf("%s.columns[%d].span", path, j), "must be between 1 and 12")
+			}
+			remainingSpan -= c.Span
+			if c.Span == 0 {
+				unsetSpan++
+			}
+		}
+		if remainingSpan < unsetSpan {
+			b.errorf(path+".columns", "spans add up to more than 12")
+		}
+
+		for j, c := range r.Columns {
+			col := GridColumn{ColSpan: c.Span}
+			if c.Span == 0 && remainingSpan > 0 {
+				col.ColSpan = remainingSpan / unsetSpan
+			}
+			if c.Chart != nil {
+				col.El = b.chart(c.Chart, fmt.Sprintf("%s.columns[%d].chart", path, j))
+			}
+			rows[k].Columns = append(rows[k].Columns, col)
+		}
+	}
+	return rows
+}
+
+// chart returns nil if the chart is invalid. The errors are added to the builder.
+func (b *specBuilder) chart(c *ChartSpec, path string) Renderable {
+	switch c.Type {
+	case "", "xy":
+		return b.xyChart(c, path)
+	case "pie", "donut":
+		return b.pieChart(c, path)
+	}
+	b.errorf(path+".type", "unknown chart type %q: expected xy, pie or donut", c.Type)
+	return nil
+}
+
+func (b *specBuilder) xyChart(c *ChartSpec, path string) Renderable {
+	switch c.X.Type {
+	case "", "category", "linear", "time":
+	default:
+		b.errorf(path+".x.type", "unknown scale %q: expected category, linear or time", c.X.Type)
+		return nil
+	}
+	if len(c.Plots) == 0 {
+		b.errorf(path+".plots", "at least one plot is required")
+		return nil
+	}
+
+	numErrs := len(b.errs)
+	series := make([]Series, len(c.Plots))
+	opts := make([][]PlotOpt, len(c.Plots))
+	hasBars := false
+	for k, p := range c.Plots {
+		plotPath := fmt.Sprintf("%s.plots[%d]", path, k)
+		switch p.Type {
+		case "lines", "points", "area":
+		case "bars":
+			hasBars = true
+		case "":
+			b.errorf(plotPath+".type", "is required")
+		default:
+			b.errorf(plotPath+".type", "unknown plot type %q: expected lines, points, bars or area", p.Type)
+		}
+		opts[k] = b.plotOpts(p, plotPath)
+		series[k] = b.plotSeries(p, plotPath, c.X.Type)
+	}
+
+	yScale := b.yScale(c.Y, path+".y", series)
+	switch c.Mode {
+	case "", "overlay":
+	case "stack", "group":
+		if c.Y.Type != "" && c.Y.Type != "linear" {
+			b.errorf(path+".mode", "%s can only be used with a linear y scale", c.Mode)
+		}
+	default:
+		b.errorf(path+".mode", "unknown mode %q: expected overlay, stack or group", c.Mode)
+	}
+	if c.X.Ticks < 0 {
+		b.errorf(path+".x.ticks", "must not be negative")
+	} else if c.X.Ticks > maxSpecTicks {
+		b.errorf(path+".x.ticks", "must be at most %d", maxSpecTicks)
+	}
+	if c.BarGap != nil && *c.BarGap < 0 {
+		b.errorf(path+".bar_gap", "must not be negative")
+	}
+	if c.GroupGap != nil && *c.GroupGap < 0 {
+		b.errorf(path+".group_gap", "must not be negative")
+	}
+	if len(b.errs) > numErrs {
+		return nil
+	}
+
+	var xScale XScale
+	switch c.X.Type {
+	case "linear":
+		xScale = NewLinearXScale(specTicks(c.X.Ticks), series...)
+	case "time":
+		xScale = NewTimeXScale(specTicks(c.X.Ticks), series...)
+	default:
+		offset := 0.0
+		if hasBars {
+			offset = 10
+		}
+		xScale = NewXScale(series[0], offset)
+	}
+
+	plots := make([]Plot, len(c.Plots))
+	for k, p := range c.Plots {
+		switch p.Type {
+		case "lines":
+			plots[k] = NewLinesPlot(yScale, xScale, series[k], opts[k]...)
+		case "points":
+			plots[k] = NewPointsPlot(yScale, xScale, series[k], opts[k]...)
+		case "bars":
+			plots[k] = NewBarsPlot(yScale, xScale, series[k], opts[k]...)
+		case "area":
+			plots[k] = NewAreaPlot(yScale, xScale, series[k], opts[k]...)
+		}
+	}
+
+	var legend []LegendEntry
+	for k, p := range c.Plots {
+		if name := b.plotName(p); name != "" {
+			legend = append(legend, NewLegendEntry(name, plots[k]))
+		}
+	}
+
+	switch c.Mode {
+	case "stack":
+		plots, yScale = StackPlots(plots...)
+	case "group":
+		plots, yScale = GroupPlots(specGap(c.BarGap, 2), specGap(c.GroupGap, 10), plots...)
+	}
+	if c.Grid == nil || *c.Grid {
+		plots = append([]Plot{NewYGrid(yScale)}, plots...)
+	}
+
+	var yOpts []YStdAxisOpt
+	if c.Y.Title != "" {
+		yOpts = append(yOpts, YAxisTitle(c.Y.Title))
+	}
+	var xOpts []XAxisOpt
+	if c.X.Title != "" {
+		xOpts = append(xOpts, XAxisTitle(c.X.Title))
+	}
+
+	layout := NewDynamicLayout(NewStdYAxis(yScale, yOpts...), NewStdXAxis(series[0], xScale, xOpts...), plots...)
+	if c.Legend && len(legend) > 0 {
+		layout.SetLegend(NewLegend(legend))
+	}
+	layout.SetTitle(c.Title)
+	layout.SetSubtitle(c.Subtitle)
+	layout.SetFootnote(c.Footnote)
+	return layout
+}
+
+// specGap is the size of a gap between bars or its default if it is not set.
+func specGap(gap *float64, def float64) float64 {
+	if gap == nil {
+		return def
+	}
+	return *gap
+}
+
+// specTicks is the number of ticks of an X scale.
+func specTicks(ticks int) int {
+	if ticks <= 0 {
+		return 10
+	}
+	return ticks
+}
+
+// yScale returns nil if the scale or any of the series are invalid.
+func (b *specBuilder) yScale(y YScaleSpec, path string, series []Series) YScale {
+	numErrs := len(b.errs)
+	if y.Ticks < 0 {
+		b.errorf(path+".ticks", "must not be negative")
+	} else if y.Ticks > maxSpecTicks {
+		b.errorf(path+".ticks", "must be at most %d", maxSpecTicks)
+	}
+	switch y.Type {
+	case "", "linear", "range":
+	case "log":
+		if y.Base != 0 && y.Base <= 1 {
+			b.errorf(path+".base", "must be greater than 1")
+		}
+	case "fixed":
+		if y.Max <= 0 {
+			b.errorf(path+".max", "must be greater than 0 for a fixed scale")
+		} else {
+			b.checkValue(path+".max", y.Max)
+		}
+	default:
+		b.errorf(path+".type", "unknown scale %q: expected linear, range, log or fixed", y.Type)
+	}
+	if len(b.errs) > numErrs {
+		return nil
+	}
+	for _, s := range series {
+		if s == nil {
+			return nil
+		}
+	}
+
+	switch y.Type {
+	case "range":
+		return NewRangeYScale(y.Ticks, series...)
+	case "log":
+		var opts []LogYScaleOpt
+		if y.Base > 1 {
+			opts = append(opts, LogBase(y.Base))
+		}
+		// a base close to 1 needs a tick for every power of the base.
+		scale := NewLogYScaleWithOpts(opts, series...)
+		if scale.NumTicks() > maxSpecTicks {
+			b.errorf(path+".base", "needs %d ticks to cover the series: use a larger base", scale.NumTicks())
+			return nil
+		}
+		return scale
+	case "fixed":
+		return NewFixedYScale(y.Ticks, y.Max)
+	}
+	return NewYScale(y.Ticks, series...)
+}
+
+func (b *specBuilder) plotOpts(p PlotSpec, path string) []PlotOpt {
+	var styles style.Opts
+	if p.Color != "" {
+		c, err := style.ParseHexColor(p.Color)
+		if err != nil {
+			b.errorf(path+".color", "%s", err)
+		}
+		styles = append(styles, style.Color(c))
+	}
+	if p.LineWidth < 0 {
+		b.errorf(path+".line_width", "must not be negative")
+	} else if p.LineWidth > 0 {
+		styles = append(styles, style.LineWidth(p.LineWidth))
+	}
+	if len(p.Dash) > 0 {
+		styles = append(styles, style.Dash(p.Dash...))
+	}
+
+	opts := []PlotOpt{PlotStyle(styles...)}
+	if p.PointSize < 0 {
+		b.errorf(path+".point_size", "must not be negative")
+	} else if p.PointSize > 0 {
+		opts = append(opts, PlotPointSize(p.PointSize))
+	}
+	switch p.Gaps {
+	case "", "break":
+	case "connect":
+		opts = append(opts, PlotGaps(GapConnect))
+	case "interpolate":
+		opts = append(opts, PlotGaps(GapInterpolate))
+	default:
+		b.errorf(path+".gaps", "unknown gap mode %q: expected break, connect or interpolate", p.Gaps)
+	}
+	return opts
+}
+
+func (b *specBuilder) pieChart(c *ChartSpec, path string) Renderable {
+	if len(c.Plots) != 1 {
+		b.errorf(path+".plots", "a %s chart must have exactly one plot", c.Type)
+		return nil
+	}
+	p := c.Plots[0]
+	if p.Type != "" {
+		b.errorf(path+".plots[0].type", "is not used by %s charts", c.Type)
+	}
+
+	opts := []PieOpt{PieStartAngle(c.Pie.StartAngle), PiePadding(c.Pie.Padding)}
+	if c.Pie.InnerRadius != nil {
+		if r := *c.Pie.InnerRadius; r < 0 || r >= 1 {
+			b.errorf(path+".pie.inner_radius", "must be at least 0 and less than 1")
+		}
+		opts = append(opts, PieInnerRadius(*c.Pie.InnerRadius))
+	}
+	switch c.Pie.Labels {
+	case "", "outside":
+	case "inside":
+		opts = append(opts, PieLabels(PieLabelsInside))
+	case "none":
+		opts = append(opts, PieLabels(PieLabelsNone))
+	default:
+		b.errorf(path+".pie.labels", "unknown label position %q: expected outside, inside or none", c.Pie.Labels)
+	}
+
+	s := b.plotSeries(p, path+".plots[0]", "category")
+	if s == nil {
+		return nil
+	}
+
+	var pie *Pie
+	if c.Type == "donut" {
+		pie = NewDonut(s, opts...)
+	} else {
+		pie = NewPie(s, opts...)
+	}
+
+	// the pie is placed in a grid so it can have titles and a legend.
+	cols := []GridColumn{{ColSpan: 12, El: pie}}
+	if c.Legend {
+		cols = []GridColumn{{ColSpan: 9, El: pie}, {ColSpan: 3, El: NewLegend(pie.LegendEntries())}}
+	}
+	layout := New12ColGridLayout(GridRow{HeightPercent: 1, Columns: cols})
+	layout.SetTitle(c.Title)
+	layout.SetSubtitle(c.Subtitle)
+	layout.SetFootnote(c.Footnote)
+	return layout
+}
+
+func (b *specBuilder) plotName(p PlotSpec) string {
+	switch {
+	case p.Name != "":
+		return p.Name
+	case p.Data != nil:
+		return p.Data.Name
+	}
+	return p.Series
+}
+
+// plotSeries returns the series of a plot with X values for the given type of X scale or nil if it is invalid.
+func (b *specBuilder) plotSeries(p PlotSpec, path, xType string) Series {
+	switch {
+	case p.Series != "" && p.Data != nil:
+		b.errorf(path+".series", "cannot be used with data")
+		return nil
+	case p.Data != nil:
+		return b.series(*p.Data, path+".data", xType)
+	case p.Series == "":
+		b.errorf(path+".series", "either series or data is required")
+		return nil
+	}
+
+	idx, ok := b.named[p.Series]
+	if !ok {
+		b.errorf(path+".series", "unknown series %q", p.Series)
+		return nil
+	}
+	key := xType + ":" + p.Series
+	if s, ok := b.cache[key]; ok {
+		return s
+	}
+	s := b.series(b.spec.Series[idx], fmt.Sprintf("series[%d]", idx), xType)
+	b.cache[key] = s
+	return s
+}
+
+func (b *specBuilder) series(s SeriesSpec, path, xType string) Series {
+	if len(s.Y) == 0 {
+		b.errorf(path+".y", "at least one value is required")
+		return nil
+	}
+	numErrs := len(b.errs)
+	y := make([]float64, len(s.Y))
+	for k, v := range s.Y {
+		y[k] = math.NaN()
+		if v != nil {
+			y[k] = *v
+			b.checkValue(fmt.Sprintf("%s.y[%d]", path, k), *v)
+		}
+	}
+	if len(b.errs) > numErrs {
+		return nil
+	}
+
+	if len(s.X) == 0 {
+		if xType == "" || xType == "category" {
+			return NewYSeries(y)
+		}
+		b.errorf(path+".x", "is required for a %s x scale", xType)
+		return nil
+	}
+	if len(s.X) != len(y) {
+		b.errorf(path+".x", "has %d values but y has %d", len(s.X), len(y))
+		return nil
+	}
+
+	switch xType {
+	case "linear":
+		x := make([]float64, len(s.X))
+		for k, v := range s.X {
+			f, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+			if err != nil {
+				b.errorf(fmt.Sprintf("%s.x[%d]", path, k), "cannot parse %q as a number", v)
+			} else {
+				b.checkValue(fmt.Sprintf("%s.x[%d]", path, k), f)
+			}
+			x[k] = f
+		}
+		if len(b.errs) > numErrs {
+			return nil
+		}
+		return NewNumericSeries(x, y)
+	case "time":
+		layout := s.TimeLayout
+		if layout == "" {
+			layout = time.RFC3339
+		}
+		x := make([]time.Time, len(s.X))
+		for k, v := range s.X {
+			t, err := time.Parse(layout, strings.TrimSpace(v))
+			if err != nil {
+				b.errorf(fmt.Sprintf("%s.x[%d]", path, k), "cannot parse %q as a time with layout %q", v, layout)
+			}
+			x[k] = t
+		}
+		if len(b.errs) > numErrs {
+			return nil
+		}
+		return NewTimeSeries(x, y)
+	}
+	return NewXYSeries(s.X, y)
+}
+
+// checkValue reports a value that is not finite or too large to plot.
+func (b *specBuilder) checkValue(path string, v float64) {
+	if math.IsNaN(v) || math.Abs(v) > MaxSpecValue {
+		b.errorf(path, "must be a finite number between %g and %g", -MaxSpecValue, MaxSpecValue)
+	}
+}
+
+// SpecFormat is an output format a spec can be encoded to.
+type SpecFormat string
+
+const (
+	FormatPNG SpecFormat = "png"
+	FormatSVG SpecFormat = "svg"
+	FormatPDF SpecFormat = "pdf"
+)
+
+// Encode renders the spec at its size and writes it to w in the given format.
+func (s *Spec) Encode(w io.Writer, format SpecFormat) error {
+	layout, err := s.Build()
+	if err != nil {
+		return err
+	}
+	return s.encode(w, format, layout)
+}
+
+// encode renders a layout built from the spec so callers that have already validated the spec do not build it again.
+func (s *Spec) encode(w io.Writer, format SpecFormat, layout *GridLayout) error {
+	width, height := s.Size()
+	switch format {
+	case FormatPNG:
+		canvas := gg.NewContext(width, height)
+		if err := s.render(canvas, layout); err != nil {
+			return err
+		}
+		return canvas.EncodePNG(w)
+	case FormatSVG:
+		canvas := NewSVGRenderer(width, height)
+		if err := s.render(canvas, layout); err != nil {
+			return err
+		}
+		return canvas.EncodeSVG(w)
+	case FormatPDF:
+		canvas := NewPDFRenderer(width, height)
+		if err := s.render(canvas, layout); err != nil {
+			return err
+		}
+		return canvas.EncodePDF(w)
+	}
+	return fmt.Errorf("unknown format %q: expected png, svg or pdf", format)
+}
@@ -0,0 +1,218 @@
+package gochart
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"image/color"
+	"io"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+)
+
+type SVGOpt func(r *SVGRenderer)
+
+// SVGFontFamily sets the font-family of all text elements. Text is still measured using the font face set on the
+// renderer so the family should be a close match to that face.
+func SVGFontFamily(family string) SVGOpt {
+	return func(r *SVGRenderer) {
+		r.fontFamily = family
+	}
+}
+
+// NewSVGRenderer creates a Renderer that records everything drawn to it as an SVG document of the given size.
+func NewSVGRenderer(width, height int, opts ...SVGOpt) *SVGRenderer {
+	r := &SVGRenderer{
+		vectorCanvas: newVectorCanvas(width, height),
+		fontFamily:   "sans-serif",
+	}
+	for _, o := range opts {
+		o(r)
+	}
+	return r
+}
+
+// SVGRenderer is a vector Renderer producing a standalone SVG document.
+type SVGRenderer struct {
+	vectorCanvas
+	fontFamily string
+	body       bytes.Buffer
+	numClips   int
+	clipID     string
+}
+
+var _ Renderer = (*SVGRenderer)(nil)
+
+func (r *SVGRenderer) Fill() {
+	r.fill(false)
+}
+
+func (r *SVGRenderer) FillPreserve() {
+	r.fill(true)
+}
+
+func (r *SVGRenderer) Stroke() {
+	r.stroke(false)
+}
+
+func (r *SVGRenderer) StrokePreserve() {
+	r.stroke(true)
+}
+
+// Clip restricts all further drawing to the current path. Like gg, the clip is not restored by Pop and must be
+// cleared with ResetClip.
+func (r *SVGRenderer) Clip() {
+	path := r.takePath(false)
+	r.numClips++
+	id := fmt.Sprintf("clip%d", r.numClips)
+	fmt.Fprintf(&r.body, `<clipPath id="%s"%s><path d="%s"/></clipPath>`+"\n", id, r.clipAttr(), svgPathData(path, true))
+	r.clipID = id
+}
+
+func (r *SVGRenderer) ResetClip() {
+	r.clipID = ""
+}
+
+func (r *SVGRenderer) DrawString(s string, x, y float64) {
+	r.DrawStringAnchored(s, x, y, 0, 0)
+}
+
+func (r *SVGRenderer) DrawStringAnchored(s string, x, y, ax, ay float64) {
+	if s == "" {
+		return
+	}
+	if nrgba(r.state.color).A == 0 {
+		return
+	}
+	x, y = r.anchorString(s, x, y, ax, ay)
+	transform := ""
+	if r.isTransformed() {
+		m := r.state.matrix
+		transform = fmt.Sprintf(` transform="matrix(%s %s %s %s %s %s)"`, svgFloat(m.XX), svgFloat(m.YX), svgFloat(m.XY), svgFloat(m.YY), svgFloat(m.X0), svgFloat(m.Y0))
+	}
+	r.writeElement(fmt.Sprintf(
+		`<text x="%s" y="%s" font-family="%s" font-size="%s"%s%s>%s</text>`,
+		svgFloat(x),
+		svgFloat(y),
+		svgEscape(r.fontFamily),
+		svgFloat(r.FontHeight()),
+		svgPaint("fill", r.state.color),
+		transform,
+		svgEscape(s),
+	))
+}
+
+// EncodeSVG writes the complete SVG document to the given writer.
+func (r *SVGRenderer) EncodeSVG(w io.Writer) error {
+	_, err := fmt.Fprintf(
+		w,
+		`<?xml version="1.0" encoding="UTF-8"?>`+"\n"+`<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d">`+"\n%s</svg>\n",
+		r.width,
+		r.height,
+		r.width,
+		r.height,
+		r.body.String(),
+	)
+	return err
+}
+
+// SaveSVG writes the SVG document to the given path.
+func (r *SVGRenderer) SaveSVG(path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := r.EncodeSVG(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+func (r *SVGRenderer) fill(preserve bool) {
+	path := r.takePath(preserve)
+	if len(path) == 0 || nrgba(r.state.color).A == 0 {
+		return
+	}
+	r.writeElement(fmt.Sprintf(`<path d="%s"%s/>`, svgPathData(path, true), svgPaint("fill", r.state.color)))
+}
+
+func (r *SVGRenderer) stroke(preserve bool) {
+	path := r.takePath(preserve)
+	if len(path) == 0 || nrgba(r.state.color).A == 0 || r.state.lineWidth <= 0 {
+		return
+	}
+	attrs := svgPaint("stroke", r.state.color) + fmt.Sprintf(` stroke-width="%s" stroke-linecap="round" stroke-linejoin="round"`, svgFloat(r.state.lineWidth))
+	if len(r.state.dashes) > 0 {
+		dashes := make([]string, len(r.state.dashes))
+		for k, d := range r.state.dashes {
+			dashes[k] = svgFloat(d)
+		}
+		attrs += fmt.Sprintf(` stroke-dasharray="%s"`, strings.Join(dashes, " "))
+	}
+	r.writeElement(fmt.Sprintf(`<path d="%s" fill="none"%s/>`, svgPathData(path, false), attrs))
+}
+
+// writeElement adds the element to the document. Clipped elements are wrapped in a group so the clip is applied in
+// canvas coordinates regardless of any transform on the element itself.
+func (r *SVGRenderer) writeElement(el string) {
+	if r.clipID != "" {
+		fmt.Fprintf(&r.body, "<g%s>%s</g>\n", r.clipAttr(), el)
+		return
+	}
+	r.body.WriteString(el)
+	r.body.WriteString("\n")
+}
+
+func (r *SVGRenderer) clipAttr() string {
+	if r.clipID == "" {
+		return ""
+	}
+	return fmt.Sprintf(` clip-path="url(#%s)"`, r.clipID)
+}
+
+func svgPaint(attr string, c color.Color) string {
+	col := nrgba(c)
+	paint := fmt.Sprintf(` %s="rgb(%d,%d,%d)"`, attr, col.R, col.G, col.B)
+	if col.A < 255 {
+		paint += fmt.Sprintf(` %s-opacity="%s"`, attr, svgFloat(float64(col.A)/255))
+	}
+	return paint
+}
+
+// svgPathData converts the path to the SVG path syntax. Subpaths are always closed for fills as they are in gg.
+func svgPathData(path []subPath, fill bool) string {
+	d := &strings.Builder{}
+	for _, sp := range path {
+		for k, p := range sp.points {
+			if k == 0 {
+				d.WriteString("M")
+			} else {
+				d.WriteString(" L")
+			}
+			d.WriteString(svgFloat(p.X))
+			d.WriteString(" ")
+			d.WriteString(svgFloat(p.Y))
+		}
+		if sp.closed || fill {
+			d.WriteString(" Z")
+		}
+		d.WriteString(" ")
+	}
+	return strings.TrimSpace(d.String())
+}
+
+// svgFloat formats the number with at most 3 decimal places which is more than enough precision for a canvas
+// measured in pixels.
+func svgFloat(v float64) string {
+	return strconv.FormatFloat(math.Round(v*1000)/1000, 'f', -1, 64)
+}
+
+func svgEscape(s string) string {
+	buf := &bytes.Buffer{}
+	// writing to a buffer cannot fail.
+	_ = xml.EscapeText(buf, []byte(s))
+	return buf.String()
+}
@@ -0,0 +1,376 @@
+package gochart
+
+import (
+	"fmt"
+	"image/color"
+	"io"
+	"math"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// the size of a cell on the canvas. This is roughly the size of a character in a terminal font so the margins and
+// label sizes used by layouts keep the same proportions as they do on an image.
+const (
+	terminalCellWidth  = 8
+	terminalCellHeight = 16
+)
+
+// the 2x2 quadrant block characters indexed by their set pixels (top left = 1, top right = 2, bottom left = 4,
+// bottom right = 8).
+var quadrantBlocks = []rune(" ▘▝▀▖▌▞▛▗▚▐▜▄▙▟█")
+
+// the bits of a braille character indexed by the column then row of the dot within the cell.
+var brailleDots = [2][4]uint8{
+	{0x01, 0x02, 0x04, 0x40},
+	{0x08, 0x10, 0x20, 0x80},
+}
+
+type TerminalOpt func(r *TerminalRenderer)
+
+// TerminalColors enables ANSI (xterm 256 colour) escape codes in the output.
+func TerminalColors() TerminalOpt {
+	return func(r *TerminalRenderer) {
+		r.colors = true
+	}
+}
+
+// TerminalBlocks uses 2x2 quadrant block characters rather than 2x4 braille characters. The output is coarser but
+// denser which suits bar charts and terminals with poor braille support.
+func TerminalBlocks() TerminalOpt {
+	return func(r *TerminalRenderer) {
+		r.dotsY = 2
+	}
+}
+
+// NewTerminalRenderer creates a Renderer that draws to a grid of text cells of the given size. Each cell is 8x16
+// pixels on the canvas and is drawn as a character made up of several dots (2x4 for braille, 2x2 for blocks). Text is
+// always drawn horizontally, one character per cell, and font faces are ignored.
+func NewTerminalRenderer(cols, rows int, opts ...TerminalOpt) *TerminalRenderer {
+	r := &TerminalRenderer{
+		vectorCanvas: newVectorCanvas(cols*terminalCellWidth, rows*terminalCellHeight),
+		cols:         cols,
+		rows:         rows,
+		dotsX:        2,
+		dotsY:        4,
+		cells:        make([]terminalCell, cols*rows),
+	}
+	for _, o := range opts {
+		o(r)
+	}
+	return r
+}
+
+// TerminalRenderer is a Renderer producing Unicode text suitable for printing to a terminal or log.
+type TerminalRenderer struct {
+	vectorCanvas
+	cols   int
+	rows   int
+	dotsX  int
+	dotsY  int
+	colors bool
+	cells  []terminalCell
+
+	// clip is a mask of the dots that may be drawn. A nil mask means no clip.
+	clip []bool
+}
+
+type terminalCell struct {
+	dots  uint8
+	text  rune
+	color color.NRGBA
+}
+
+var _ Renderer = (*TerminalRenderer)(nil)
+
+func (r *TerminalRenderer) FontHeight() float64 {
+	return terminalCellHeight
+}
+
+func (r *TerminalRenderer) MeasureString(s string) (w, h float64) {
+	return float64(utf8.RuneCountInString(s) * terminalCellWidth), terminalCellHeight
+}
+
+func (r *TerminalRenderer) Fill() {
+	r.fill(false)
+}
+
+func (r *TerminalRenderer) FillPreserve() {
+	r.fill(true)
+}
+
+func (r *TerminalRenderer) Stroke() {
+	r.stroke(false)
+}
+
+func (r *TerminalRenderer) StrokePreserve() {
+	r.stroke(true)
+}
+
+// Clip restricts all further drawing to the current path. Like gg, the clip is not restored by Pop and must be
+// cleared with ResetClip.
+func (r *TerminalRenderer) Clip() {
+	mask := make([]bool, r.cols*r.dotsX*r.rows*r.dotsY)
+	r.scanDots(r.takePath(false), func(x, y int) {
+		i := y*r.cols*r.dotsX + x
+		mask[i] = r.clip == nil || r.clip[i]
+	})
+	r.clip = mask
+}
+
+func (r *TerminalRenderer) ResetClip() {
+	r.clip = nil
+}
+
+func (r *TerminalRenderer) DrawString(s string, x, y float64) {
+	r.DrawStringAnchored(s, x, y, 0, 0)
+}
+
+// DrawStringAnchored writes the string into the row of cells containing the vertical center of the text.
+func (r *TerminalRenderer) DrawStringAnchored(s string, x, y, ax, ay float64) {
+	c := nrgba(r.state.color)
+	if c.A == 0 {
+		return
+	}
+	x, y = r.anchorString(s, x, y, ax, ay)
+	x, y = r.state.matrix.TransformPoint(x, y-terminalCellHeight/2)
+
+	row := int(math.Floor(y / terminalCellHeight))
+	col := int(math.Round(x / terminalCellWidth))
+	if row < 0 || row >= r.rows {
+		return
+	}
+	for _, ch := range s {
+		if col >= 0 && col < r.cols && r.visible(col*r.dotsX, row*r.dotsY) {
+			cell := &r.cells[row*r.cols+col]
+			cell.text = ch
+			cell.color = c
+		}
+		col++
+	}
+}
+
+// String returns the rendered text.
+func (r *TerminalRenderer) String() string {
+	sb := &strings.Builder{}
+	for row := 0; row < r.rows; row++ {
+		line := r.cells[row*r.cols : (row+1)*r.cols]
+
+		// trailing empty cells are dropped to keep logs tidy.
+		end := len(line)
+		for end > 0 && line[end-1].text == 0 && line[end-1].dots == 0 {
+			end--
+		}
+
+		var current *color.NRGBA
+		for k := range line[:end] {
+			cell := line[k]
+			if r.colors && (cell.text != 0 || cell.dots != 0) && (current == nil || *current != cell.color) {
+				sb.WriteString(fmt.Sprintf("\x1b[38;5;%dm", xterm256(cell.color)))
+				current = &line[k].color
+			}
+			sb.WriteRune(r.cellRune(cell))
+		}
+		if r.colors && current != nil {
+			sb.WriteString("\x1b[0m")
+		}
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
+
+// EncodeText writes the rendered text to the given writer.
+func (r *TerminalRenderer) EncodeText(w io.Writer) error {
+	_, err := io.WriteString(w, r.String())
+	return err
+}
+
+func (r *TerminalRenderer) cellRune(cell terminalCell) rune {
+	if cell.text != 0 {
+		return cell.text
+	}
+	if cell.dots == 0 {
+		return ' '
+	}
+	if r.dotsY == 2 {
+		return quadrantBlocks[cell.dots]
+	}
+	return rune(0x2800 + int(cell.dots))
+}
+
+func (r *TerminalRenderer) fill(preserve bool) {
+	path := r.takePath(preserve)
+	c := nrgba(r.state.color)
+	if c.A == 0 {
+		return
+	}
+	r.scanDots(path, func(x, y int) {
+		r.setDot(x, y, c)
+	})
+}
+
+func (r *TerminalRenderer) stroke(preserve bool) {
+	path := r.takePath(preserve)
+	c := nrgba(r.state.color)
+	if c.A == 0 || r.state.lineWidth <= 0 {
+		return
+	}
+	dotW := float64(terminalCellWidth / r.dotsX)
+	dotH := float64(terminalCellHeight / r.dotsY)
+	dashLength := 0.0
+	for _, d := range r.state.dashes {
+		dashLength += d
+	}
+	for _, sp := range path {
+		points := sp.points
+		if sp.closed {
+			points = append(append([]point{}, points...), points[0])
+		}
+		// distance travelled along the subpath, used to apply the dash pattern.
+		distance := 0.0
+		for k := 1; k < len(points); k++ {
+			from, to := points[k-1], points[k]
+			length := math.Hypot(to.X-from.X, to.Y-from.Y)
+			// step at least twice per dot so no dots are skipped.
+			steps := int(math.Ceil(2*math.Max(math.Abs(to.X-from.X)/dotW, math.Abs(to.Y-from.Y)/dotH))) + 1
+			for s := 0; s <= steps; s++ {
+				t := float64(s) / float64(steps)
+				if dashLength > 0 && !dashOn(r.state.dashes, math.Mod(distance+length*t, dashLength)) {
+					continue
+				}
+				r.setDot(
+					int(math.Floor((from.X+(to.X-from.X)*t)/dotW)),
+					int(math.Floor((from.Y+(to.Y-from.Y)*t)/dotH)),
+					c,
+				)
+			}
+			distance += length
+		}
+	}
+}
+
+// setDot sets the dot with the given index (not canvas position). Faint colours only colour a cell that has no colour
+// yet so that e.g. grid lines do not change the colour of the bars they pass behind.
+func (r *TerminalRenderer) setDot(x, y int, c color.NRGBA) {
+	if x < 0 || y < 0 || x >= r.cols*r.dotsX || y >= r.rows*r.dotsY || !r.visible(x, y) {
+		return
+	}
+	cell := &r.cells[(y/r.dotsY)*r.cols+(x/r.dotsX)]
+	if cell.dots == 0 || c.A >= 128 {
+		cell.color = c
+	}
+	if r.dotsY == 2 {
+		cell.dots |= 1 << uint((y%2)*2+(x%2))
+		return
+	}
+	cell.dots |= brailleDots[x%2][y%4]
+}
+
+func (r *TerminalRenderer) visible(x, y int) bool {
+	return r.clip == nil || r.clip[y*r.cols*r.dotsX+x]
+}
+
+// scanDots calls set for every dot whose center is inside the path.
+func (r *TerminalRenderer) scanDots(path []subPath, set func(x, y int)) {
+	dotW := float64(terminalCellWidth / r.dotsX)
+	dotH := float64(terminalCellHeight / r.dotsY)
+
+	// scale the path so each dot is one unit.
+	scaled := make([]subPath, len(path))
+	for k, sp := range path {
+		scaled[k] = subPath{points: make([]point, len(sp.points)), closed: sp.closed}
+		for i, p := range sp.points {
+			scaled[k].points[i] = point{X: p.X / dotW, Y: p.Y / dotH}
+		}
+	}
+	scanFill(scaled, r.cols*r.dotsX, r.rows*r.dotsY, set)
+}
+
+func dashOn(dashes []float64, pos float64) bool {
+	for k := 0; ; k++ {
+		d := dashes[k%len(dashes)]
+		if pos < d {
+			return k%2 == 0
+		}
+		pos -= d
+	}
+}
+
+// scanFill calls set for every pixel whose center is inside the path using the non-zero winding rule.
+func scanFill(path []subPath, width, height int, set func(x, y int)) {
+	type crossing struct {
+		x   float64
+		dir int
+	}
+	for y := 0; y < height; y++ {
+		cy := float64(y) + 0.5
+		var crossings []crossing
+		for _, sp := range path {
+			n := len(sp.points)
+			for k := 0; k < n; k++ {
+				from, to := sp.points[k], sp.points[(k+1)%n]
+				if (from.Y <= cy) == (to.Y <= cy) {
+					continue
+				}
+				dir := 1
+				if to.Y < from.Y {
+					dir = -1
+				}
+				crossings = append(crossings, crossing{x: from.X + (cy-from.Y)/(to.Y-from.Y)*(to.X-from.X), dir: dir})
+			}
+		}
+		sort.Slice(crossings, func(i, j int) bool { return crossings[i].x < crossings[j].x })
+
+		winding := 0
+		for k := 0; k < len(crossings)-1; k++ {
+			winding += crossings[k].dir
+			if winding == 0 {
+				continue
+			}
+			start := int(math.Max(math.Ceil(crossings[k].x-0.5), 0))
+			end := int(math.Min(math.Ceil(crossings[k+1].x-0.5), float64(width)))
+			for x := start; x < end; x++ {
+				set(x, y)
+			}
+		}
+	}
+}
+
+// xterm256 finds the closest colour in the xterm 6x6x6 colour cube or greyscale ramp.
+func xterm256(c color.NRGBA) int {
+	cube := func(v uint8) int {
+		if v < 48 {
+			return 0
+		}
+		if v < 115 {
+			return 1
+		}
+		return (int(v) - 35) / 40
+	}
+	level := func(i int) int {
+		if i == 0 {
+			return 0
+		}
+		return 55 + i*40
+	}
+	distance := func(r, g, b int) int {
+		dr, dg, db := int(c.R)-r, int(c.G)-g, int(c.B)-b
+		return dr*dr + dg*dg + db*db
+	}
+
+	r, g, b := cube(c.R), cube(c.G), cube(c.B)
+	cubeIndex := 16 + 36*r + 6*g + b
+	cubeDistance := distance(level(r), level(g), level(b))
+
+	grey := (int(c.R) + int(c.G) + int(c.B)) / 3
+	greyStep := 23
+	if grey < 238 {
+		greyStep = int(math.Max(float64(grey-3)/10, 0))
+	}
+	greyLevel := 8 + greyStep*10
+	if distance(greyLevel, greyLevel, greyLevel) < cubeDistance {
+		return 232 + greyStep
+	}
+	return cubeIndex
+}
@@ -0,0 +1,165 @@
+package gochart
+
+import (
+	"image/color"
+
+	"github.com/warmans/gochart/pkg/style"
+)
+
+// Theme is the default appearance of charts: the colours given to plots along with the styles of the background,
+// axes, grids and text. Styles set explicitly on a component always take priority over the theme.
+type Theme struct {
+	// Palette is the colours given to the plots of a layout in order.
+	Palette style.Palette
+	// Background is drawn behind layouts within their bounding box unless it is transparent.
+	Background color.RGBA
+	// AxisStyles are used for the lines of axes.
+	AxisStyles style.Opts
+	// FontStyles are used for titles and labels e.g. to set a font face for the whole chart.
+	FontStyles style.Opts
+	// SecondaryFontStyles are used for subtitles and footnotes.
+	SecondaryFontStyles style.Opts
+	GridStyles          style.Opts
+	GridMinorStyles     style.Opts
+	GridZeroStyles      style.Opts
+	// LegendBackgroundStyles are used for the box drawn behind legends.
+	LegendBackgroundStyles style.Opts
+}
+
+// DefaultTheme is used by components when they are created. It can be replaced to change the look of every chart
+// created afterwards.
+var DefaultTheme = Theme{
+	Palette:                style.Categorical,
+	AxisStyles:             style.DefaultAxisOpts,
+	FontStyles:             style.Opts{style.Color(color.RGBA{A: 255})},
+	SecondaryFontStyles:    style.Opts{style.Color(color.RGBA{A: 160})},
+	GridStyles:             style.Opts{style.Color(color.RGBA{A: 64})},
+	GridMinorStyles:        style.Opts{style.Color(color.RGBA{A: 24})},
+	GridZeroStyles:         style.Opts{style.Color(color.RGBA{A: 192})},
+	LegendBackgroundStyles: style.Opts{style.Color(color.RGBA{R: 200, G: 200, B: 200, A: 200})},
+}
+
+// DarkTheme draws light text and lines on a dark background.
+var DarkTheme = Theme{
+	Palette:                style.Categorical,
+	Background:             color.RGBA{R: 32, G: 34, B: 37, A: 255},
+	AxisStyles:             style.Opts{style.Color(color.RGBA{R: 200, G: 200, B: 200, A: 255}), style.LineWidth(2)},
+	FontStyles:             style.Opts{style.Color(color.RGBA{R: 230, G: 230, B: 230, A: 255})},
+	SecondaryFontStyles:    style.Opts{style.Color(color.RGBA{R: 160, G: 160, B: 160, A: 255})},
+	GridStyles:             style.Opts{style.Color(color.RGBA{R: 48, G: 48, B: 48, A: 48})},
+	GridMinorStyles:        style.Opts{style.Color(color.RGBA{R: 20, G: 20, B: 20, A: 20})},
+	GridZeroStyles:         style.Opts{style.Color(color.RGBA{R: 160, G: 160, B: 160, A: 160})},
+	LegendBackgroundStyles: style.Opts{style.Color(color.RGBA{R: 48, G: 50, B: 54, A: 220})},
+}
+
+// Apply changes a layout and everything in it to the theme. The plots of each DynamicLayout, CompositePlot and the
+// plots placed directly in the cells of a GridLayout are given colours from the palette in order.
+func (t Theme) Apply(r Renderable) {
+	switch el := r.(type) {
+	case *GridLayout:
+		el.background = t.Background
+		t.applyTitles(&el.Titles)
+		for _, row := range el.rows {
+			for _, col := range row.Columns {
+				if col.El != nil {
+					t.Apply(col.El)
+				}
+			}
+		}
+		t.colorPlots(gridPlots(el.rows))
+	case *DynamicLayout:
+		el.background = t.Background
+		t.applyTitles(&el.Titles)
+		t.Apply(el.yAxis)
+		t.Apply(el.xAxis)
+		if el.legend != nil {
+			t.Apply(el.legend)
+		}
+		for _, ch := range el.charts {
+			t.Apply(ch)
+		}
+		t.colorPlots(el.charts)
+	case *CompositePlot:
+		for _, p := range el.plots {
+			t.Apply(p)
+		}
+		t.colorPlots(el.plots)
+	case *YStdAxis:
+		t.applyAxis(&el.lineStyles, &el.fontStyles, &el.title)
+	case *XStdAxis:
+		t.applyAxis(&el.lineStyles, &el.fontStyles, &el.title)
+	case *XAxisCompact:
+		t.applyAxis(&el.lineStyles, &el.fontStyles, nil)
+	case *CategoryYAxis:
+		t.applyAxis(&el.lineStyles, &el.fontStyles, nil)
+	case *HorizontalValueAxis:
+		t.applyAxis(&el.lineStyles, &el.fontStyles, &el.title)
+	case *YGrid:
+		el.Styles.setTheme(t.GridStyles)
+		el.minorStyles.setTheme(t.GridMinorStyles)
+		el.zeroStyles.setTheme(t.GridZeroStyles)
+	case *HorizontalGrid:
+		el.Styles.setTheme(t.GridStyles)
+		el.zeroStyles.setTheme(t.GridZeroStyles)
+	case *BoxPlot:
+		el.lineStyles.setTheme(t.FontStyles)
+		el.outlierStyles.setTheme(t.FontStyles)
+	case *Legend:
+		el.fontStyles.setTheme(t.FontStyles)
+		el.bgStyles.setTheme(t.LegendBackgroundStyles)
+	case *Pie:
+		el.fontStyles.setTheme(t.FontStyles)
+		for k := range el.sliceStyles {
+			el.sliceStyles[k].setTheme(style.Opts{style.Color(t.Palette.Color(k))})
+		}
+	case *ColorBar:
+		el.fontStyles.setTheme(t.FontStyles)
+		el.lineStyles.setTheme(t.FontStyles)
+	}
+}
+
+func (t Theme) applyTitles(titles *Titles) {
+	titles.titleStyles.setTheme(t.FontStyles)
+	titles.subtitleStyles.setTheme(t.SecondaryFontStyles)
+	titles.footnoteStyles.setTheme(t.SecondaryFontStyles)
+}
+
+func (t Theme) applyAxis(lineStyles, fontStyles *Styles, title *axisTitle) {
+	lineStyles.setTheme(t.AxisStyles)
+	fontStyles.setTheme(t.FontStyles)
+	if title != nil {
+		title.styles.setTheme(t.FontStyles)
+	}
+}
+
+// colorPlots gives each plot that draws a series the next colour from the palette.
+func (t Theme) colorPlots(plots []Plot) {
+	next := 0
+	var walk func(r Renderable)
+	walk = func(r Renderable) {
+		switch p := r.(type) {
+		case *CompositePlot:
+			for _, inner := range p.plots {
+				walk(inner)
+			}
+		case *LinesPlot, *PointsPlot, *BarsPlot, *HorizontalBarsPlot, *AreaPlot, *BoxPlot:
+			p.(interface{ setTheme(style.Opts) }).setTheme(style.Opts{style.Color(t.Palette.Color(next))})
+			next++
+		}
+	}
+	for _, p := range plots {
+		walk(p)
+	}
+}
+
+// drawBackground fills the container with the background colour unless it is transparent.
+func drawBackground(canvas Renderer, background color.RGBA, container BoundingBox) {
+	if background.A == 0 {
+		return
+	}
+	canvas.Push()
+	defer canvas.Pop()
+	canvas.SetColor(background)
+	canvas.DrawRectangle(container.X, container.Y, container.W, container.H)
+	canvas.Fill()
+}
@@ -0,0 +1,85 @@
+package gochart
+
+import "github.com/warmans/gochart/pkg/style"
+
+func newTitles() Titles {
+	return Titles{
+		titleStyles:    newThemeStyles(DefaultTheme.FontStyles),
+		subtitleStyles: newThemeStyles(DefaultTheme.SecondaryFontStyles),
+		footnoteStyles: newThemeStyles(DefaultTheme.SecondaryFontStyles),
+	}
+}
+
+// Titles adds a title and subtitle above and a footnote below a layout. The space they need is removed from the
+// container before the rest of the layout is calculated.
+type Titles struct {
+	title          string
+	subtitle       string
+	footnote       string
+	titleStyles    Styles
+	subtitleStyles Styles
+	footnoteStyles Styles
+}
+
+func (t *Titles) SetTitle(title string, opt ...style.Opt) {
+	t.title = title
+	t.titleStyles.SetStyle(opt...)
+}
+
+func (t *Titles) SetSubtitle(subtitle string, opt ...style.Opt) {
+	t.subtitle = subtitle
+	t.subtitleStyles.SetStyle(opt...)
+}
+
+func (t *Titles) SetFootnote(footnote string, opt ...style.Opt) {
+	t.footnote = footnote
+	t.footnoteStyles.SetStyle(opt...)
+}
+
+// renderTitles draws the titles and returns the space left over for the rest of the layout.
+func (t *Titles) renderTitles(canvas Renderer, container BoundingBox) BoundingBox {
+	remaining := container
+
+	if t.title != "" {
+		h := titleHeight(canvas, t.titleStyles)
+		drawTitle(canvas, t.title, t.titleStyles, remaining.RelX(remaining.W/2), remaining.RelY(0), h, 0.5)
+		remaining.Y += h
+		remaining.H -= h
+	}
+	if t.subtitle != "" {
+		h := titleHeight(canvas, t.subtitleStyles)
+		drawTitle(canvas, t.subtitle, t.subtitleStyles, remaining.RelX(remaining.W/2), remaining.RelY(0), h, 0.5)
+		remaining.Y += h
+		remaining.H -= h
+	}
+	if t.title != "" || t.subtitle != "" {
+		// leave a gap between the titles and the content.
+		remaining.Y += defaultMargin
+		remaining.H -= defaultMargin
+	}
+	if t.footnote != "" {
+		h := titleHeight(canvas, t.footnoteStyles)
+		drawTitle(canvas, t.footnote, t.footnoteStyles, remaining.RelX(0), remaining.RelY(remaining.H)-h, h, 0)
+		remaining.H -= h
+	}
+	return remaining
+}
+
+// titleHeight is the height of a line of text in the given styles including a margin.
+func titleHeight(canvas Renderer, styles Styles) float64 {
+	canvas.Push()
+	defer canvas.Pop()
+
+	styles.styleOpts.Apply(canvas)
+	return canvas.FontHeight() + defaultMargin
+}
+
+// drawTitle draws a line of text vertically centered within the given height starting at y. The text is aligned to x
+// as in DrawStringAnchored.
+func drawTitle(canvas Renderer, s string, styles Styles, x, y, h, align float64) {
+	canvas.Push()
+	defer canvas.Pop()
+
+	styles.styleOpts.Apply(canvas)
+	canvas.DrawStringAnchored(s, x, y+h/2, align, 0.35)
+}
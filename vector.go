@@ -0,0 +1,215 @@
+package gochart
+
+import (
+	"image/color"
+	"math"
+
+	"github.com/fogleman/gg"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/basicfont"
+)
+
+type point struct {
+	X float64
+	Y float64
+}
+
+type subPath struct {
+	points []point
+	closed bool
+}
+
+// vectorState is the part of a vectorCanvas that is saved and restored by Push/Pop.
+type vectorState struct {
+	color      color.Color
+	dashes     []float64
+	lineWidth  float64
+	fontFace   font.Face
+	fontHeight float64
+	matrix     gg.Matrix
+}
+
+// vectorCanvas implements the path building, transform and text measuring parts of the Renderer with the same
+// semantics as gg. Non-raster backends embed it and only need to implement the painting operations.
+type vectorCanvas struct {
+	width  int
+	height int
+
+	state vectorState
+	stack []vectorState
+
+	// path is kept in canvas coordinates i.e. with the transform already applied.
+	path       []subPath
+	hasCurrent bool
+}
+
+func newVectorCanvas(width, height int) vectorCanvas {
+	return vectorCanvas{
+		width:  width,
+		height: height,
+		state: vectorState{
+			color:      color.Transparent,
+			lineWidth:  1,
+			fontFace:   basicfont.Face7x13,
+			fontHeight: 13,
+			matrix:     gg.Identity(),
+		},
+	}
+}
+
+func (c *vectorCanvas) Width() int {
+	return c.width
+}
+
+func (c *vectorCanvas) Height() int {
+	return c.height
+}
+
+func (c *vectorCanvas) SetColor(col color.Color) {
+	c.state.color = col
+}
+
+func (c *vectorCanvas) SetDash(dashes ...float64) {
+	c.state.dashes = dashes
+}
+
+func (c *vectorCanvas) SetLineWidth(lineWidth float64) {
+	c.state.lineWidth = lineWidth
+}
+
+func (c *vectorCanvas) SetFontFace(fontFace font.Face) {
+	c.state.fontFace = fontFace
+	c.state.fontHeight = float64(fontFace.Metrics().Height) / 64
+}
+
+func (c *vectorCanvas) Push() {
+	c.stack = append(c.stack, c.state)
+}
+
+func (c *vectorCanvas) Pop() {
+	if len(c.stack) == 0 {
+		return
+	}
+	c.state, c.stack = c.stack[len(c.stack)-1], c.stack[:len(c.stack)-1]
+}
+
+func (c *vectorCanvas) RotateAbout(angle, x, y float64) {
+	c.state.matrix = c.state.matrix.Translate(x, y).Rotate(angle).Translate(-x, -y)
+}
+
+func (c *vectorCanvas) MoveTo(x, y float64) {
+	x, y = c.state.matrix.TransformPoint(x, y)
+	c.path = append(c.path, subPath{points: []point{{X: x, Y: y}}})
+	c.hasCurrent = true
+}
+
+func (c *vectorCanvas) LineTo(x, y float64) {
+	if !c.hasCurrent {
+		c.MoveTo(x, y)
+		return
+	}
+	x, y = c.state.matrix.TransformPoint(x, y)
+	current := &c.path[len(c.path)-1]
+	current.points = append(current.points, point{X: x, Y: y})
+}
+
+func (c *vectorCanvas) ClosePath() {
+	if c.hasCurrent {
+		c.path[len(c.path)-1].closed = true
+		// further segments continue from the start of the closed subpath.
+		start := c.path[len(c.path)-1].points[0]
+		c.path = append(c.path, subPath{points: []point{start}})
+	}
+}
+
+func (c *vectorCanvas) NewSubPath() {
+	c.hasCurrent = false
+}
+
+func (c *vectorCanvas) ClearPath() {
+	c.path = nil
+	c.hasCurrent = false
+}
+
+func (c *vectorCanvas) DrawLine(x1, y1, x2, y2 float64) {
+	c.MoveTo(x1, y1)
+	c.LineTo(x2, y2)
+}
+
+func (c *vectorCanvas) DrawRectangle(x, y, w, h float64) {
+	c.NewSubPath()
+	c.MoveTo(x, y)
+	c.LineTo(x+w, y)
+	c.LineTo(x+w, y+h)
+	c.LineTo(x, y+h)
+	c.ClosePath()
+}
+
+func (c *vectorCanvas) DrawCircle(x, y, r float64) {
+	c.NewSubPath()
+	c.DrawArc(x, y, r, 0, 2*math.Pi)
+	c.ClosePath()
+}
+
+// DrawArc approximates the arc with line segments. The number of segments grows with the radius so large
+// arcs stay smooth when the output is scaled.
+func (c *vectorCanvas) DrawArc(x, y, r, angle1, angle2 float64) {
+	sweep := angle2 - angle1
+	n := int(math.Ceil(math.Abs(sweep) / (2 * math.Pi) * math.Max(16, r)))
+	if n < 1 {
+		n = 1
+	}
+	if n > 256 {
+		n = 256
+	}
+	for i := 0; i <= n; i++ {
+		a := angle1 + sweep*float64(i)/float64(n)
+		px, py := x+r*math.Cos(a), y+r*math.Sin(a)
+		if i == 0 && !c.hasCurrent {
+			c.MoveTo(px, py)
+			continue
+		}
+		c.LineTo(px, py)
+	}
+}
+
+func (c *vectorCanvas) FontHeight() float64 {
+	return c.state.fontHeight
+}
+
+func (c *vectorCanvas) MeasureString(s string) (w, h float64) {
+	d := &font.Drawer{Face: c.state.fontFace}
+	return float64(d.MeasureString(s) >> 6), c.state.fontHeight
+}
+
+// takePath returns the non-empty subpaths of the current path. Unless preserve is set the path is then cleared.
+func (c *vectorCanvas) takePath(preserve bool) []subPath {
+	path := make([]subPath, 0, len(c.path))
+	for _, sp := range c.path {
+		if len(sp.points) > 1 {
+			path = append(path, sp)
+		}
+	}
+	if !preserve {
+		c.ClearPath()
+	}
+	return path
+}
+
+// anchorString returns the baseline position of a string anchored at the given point.
+func (c *vectorCanvas) anchorString(s string, x, y, ax, ay float64) (float64, float64) {
+	w, h := c.MeasureString(s)
+	return x - ax*w, y + ay*h
+}
+
+func (c *vectorCanvas) isTransformed() bool {
+	return c.state.matrix != gg.Identity()
+}
+
+// nrgba converts any colour to non-alpha-premultiplied 8 bit components.
+func nrgba(c color.Color) color.NRGBA {
+	if c == nil {
+		return color.NRGBA{}
+	}
+	return color.NRGBAModel.Convert(c).(color.NRGBA)
+}